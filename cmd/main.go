@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
 
 	"github.com/aparnasukesh/movies-booking-svc/config"
 	"github.com/aparnasukesh/movies-booking-svc/internal/di"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
 )
 
 func main() {
@@ -12,9 +15,32 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	server, err := di.InitResources(cfg)
-
-	if err := server(); err != nil {
+	appLogger, err := logger.New(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(os.Args) > 1 {
+		var err error
+		switch os.Args[1] {
+		case "migrate":
+			err = runMigrate(cfg, appLogger, os.Args[2:])
+		case "seed":
+			err = runSeed(cfg, appLogger, os.Args[2:])
+		case "payment-report":
+			err = runPaymentReport(cfg, appLogger, os.Args[2:])
+		default:
+			log.Fatalf("unknown command %q", os.Args[1])
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	app, err := di.InitResources(cfg, appLogger)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := app.Run(context.Background()); err != nil {
 		log.Fatal(err)
 	}
 
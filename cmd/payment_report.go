@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/internal/di"
+	grpclient "github.com/aparnasukesh/movies-booking-svc/pkg/grpClient"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	redis "github.com/aparnasukesh/movies-booking-svc/pkg/redis"
+	sql "github.com/aparnasukesh/movies-booking-svc/pkg/sql"
+)
+
+const paymentReportUsage = `usage: movies-booking-svc payment-report [YYYY-MM-DD]
+
+compares the bookings made on the given UTC day (default yesterday) with the
+payment service and stores the ones that disagree. Payments are looked up
+from bookings only, so payments without a booking are not found`
+
+func runPaymentReport(cfg config.Config, logger *slog.Logger, args []string) error {
+	if len(args) > 1 {
+		return errors.New(paymentReportUsage)
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := today.AddDate(0, 0, -1)
+	if len(args) == 1 {
+		day, err := time.Parse("2006-01-02", args[0])
+		if err != nil {
+			return fmt.Errorf("invalid day %q: %s", args[0], paymentReportUsage)
+		}
+		from = day
+	}
+	to := from.AddDate(0, 0, 1)
+
+	db, err := sql.NewSql(cfg)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	redisClient, err := redis.NewRedis(cfg)
+	if err != nil {
+		return err
+	}
+	defer redisClient.Close()
+	clients, err := grpclient.NewFactory(cfg)
+	if err != nil {
+		return err
+	}
+	paymentClient, paymentConn, err := clients.NewBookingPaymentServiceClient()
+	if err != nil {
+		return err
+	}
+	defer paymentConn.Close()
+	userAdminClient, userAdminConn, err := clients.NewUserAdminServiceClient()
+	if err != nil {
+		return err
+	}
+	defer userAdminConn.Close()
+
+	services, err := di.NewServices(cfg, db, redisClient, paymentClient, grpclient.NewUserDirectory(userAdminClient, cfg.UserAdminCacheTTL), metrics.New())
+	if err != nil {
+		return err
+	}
+	report, err := services.Booking.PaymentReport(context.Background(), from, to)
+	if err != nil {
+		return err
+	}
+	logger.Info("payment report complete", "report_id", report.ID, "from", from, "to", to,
+		"checked", report.Checked, "mismatched", report.Mismatched)
+	if report.Mismatched == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BOOKING\tTRANSACTION\tKIND\tBOOKING STATUS\tPAYMENT STATUS\tBOOKING AMOUNT\tPAYMENT AMOUNT")
+	for _, m := range report.Mismatches {
+		transaction, paymentAmount := "-", "-"
+		if m.TransactionID != nil {
+			transaction = strconv.Itoa(int(*m.TransactionID))
+		}
+		if m.PaymentAmount != nil {
+			paymentAmount = fmt.Sprintf("%.2f", *m.PaymentAmount)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%.2f\t%s\n", m.BookingID, transaction, m.Kind,
+			m.BookingStatus, m.PaymentStatus, m.BookingAmount, paymentAmount)
+	}
+	return w.Flush()
+}
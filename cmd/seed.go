@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/internal/di"
+	"github.com/aparnasukesh/movies-booking-svc/internal/seed"
+	grpclient "github.com/aparnasukesh/movies-booking-svc/pkg/grpClient"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	redis "github.com/aparnasukesh/movies-booking-svc/pkg/redis"
+	sql "github.com/aparnasukesh/movies-booking-svc/pkg/sql"
+)
+
+const seedUsage = `usage: movies-booking-svc seed [--allow-all-users] <fixture.yaml|fixture.json>
+
+  --allow-all-users  treat every user and theater admin as verified instead of
+                     asking the user-admin service. For local data only.`
+
+func runSeed(cfg config.Config, logger *slog.Logger, args []string) error {
+	allowAllUsers := len(args) == 2 && args[0] == "--allow-all-users"
+	if allowAllUsers {
+		args = args[1:]
+	}
+	if len(args) != 1 {
+		return errors.New(seedUsage)
+	}
+	fixture, err := seed.LoadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.NewSql(cfg)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	redisClient, err := redis.NewRedis(cfg)
+	if err != nil {
+		return err
+	}
+	defer redisClient.Close()
+	// Seeding never charges anyone, but the booking service needs a client.
+	clients, err := grpclient.NewFactory(cfg)
+	if err != nil {
+		return err
+	}
+	paymentClient, paymentConn, err := clients.NewBookingPaymentServiceClient()
+	if err != nil {
+		return err
+	}
+	defer paymentConn.Close()
+	var users grpclient.UserDirectory
+	if allowAllUsers {
+		logger.Warn("seeding with --allow-all-users, every user and theater admin counts as verified")
+		users = grpclient.NewAllowAllDirectory()
+	} else {
+		userAdminClient, userAdminConn, err := clients.NewUserAdminServiceClient()
+		if err != nil {
+			return err
+		}
+		defer userAdminConn.Close()
+		users = grpclient.NewUserDirectory(userAdminClient, cfg.UserAdminCacheTTL)
+	}
+
+	services, err := di.NewServices(cfg, db, redisClient, paymentClient, users, metrics.New())
+	if err != nil {
+		return err
+	}
+	loader := seed.NewLoader(services.Movies, services.Theatres, services.Booking, logger)
+	if err := loader.Load(context.Background(), fixture); err != nil {
+		return err
+	}
+	logger.Info("seed complete", "fixture", args[0])
+	return nil
+}
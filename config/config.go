@@ -2,27 +2,127 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	DBHost                   string `mapstructure:"DBHOST" validate:"required"`
-	DBName                   string `mapstructure:"DBNAME" validate:"required"`
-	DBUser                   string `mapstructure:"DBUSER" validate:"required"`
-	DBPort                   string `mapstructure:"DBPORT" validate:"required"`
-	DBPassword               string `mapstructure:"DBPASSWORD" validate:"required"`
-	GrpcPort                 string `mapstructure:"GRPCPORT" validate:"required"`
-	GrpcNotificationPort     string `mapstructure:"GrpcNotificationPort" validate:"required"`
-	GrpcUserAdminServicePort string `mapstructure:"GrpcUserAdminServicePort" validate:"required"`
-	RedisPort                string `mapstructure:"RedisPort" validate:"required"`
-	RedisHost                string `mapstructure:"REDISHOST" validate:"required"`
-	GrpcPaymentPort          string `mapstructure:"GrpcPaymentPort" validate:"required"`
+	DBHost                     string        `mapstructure:"DBHOST" validate:"required"`
+	DBName                     string        `mapstructure:"DBNAME" validate:"required"`
+	DBUser                     string        `mapstructure:"DBUSER" validate:"required"`
+	DBPort                     string        `mapstructure:"DBPORT" validate:"required"`
+	DBPassword                 string        `mapstructure:"DBPASSWORD" validate:"required"`
+	GrpcPort                   string        `mapstructure:"GRPCPORT" validate:"required"`
+	GrpcNotificationPort       string        `mapstructure:"GrpcNotificationPort"`
+	GrpcUserAdminServicePort   string        `mapstructure:"GrpcUserAdminServicePort"`
+	RedisPort                  string        `mapstructure:"RedisPort" validate:"required"`
+	RedisHost                  string        `mapstructure:"REDISHOST" validate:"required"`
+	GrpcPaymentPort            string        `mapstructure:"GrpcPaymentPort"`
+	TicketSigningKey           string        `mapstructure:"TicketSigningKey" validate:"required"`
+	CheckInOpensBefore         time.Duration `mapstructure:"CheckInOpensBefore"`
+	CheckInClosesAfter         time.Duration `mapstructure:"CheckInClosesAfter"`
+	JWTSecret                  string        `mapstructure:"JWTSecret"`
+	JWKSFile                   string        `mapstructure:"JWKSFile"`
+	JWTIssuer                  string        `mapstructure:"JWTIssuer"`
+	JWTAudience                string        `mapstructure:"JWTAudience"`
+	RPCTimeout                 time.Duration `mapstructure:"RPCTimeout"`
+	LogLevel                   string        `mapstructure:"LogLevel"`
+	LogFormat                  string        `mapstructure:"LogFormat"`
+	MetricsPort                string        `mapstructure:"MetricsPort"`
+	TraceExporter              string        `mapstructure:"TraceExporter"`
+	OTLPEndpoint               string        `mapstructure:"OTLPEndpoint"`
+	OTLPInsecure               bool          `mapstructure:"OTLPInsecure"`
+	TraceSampleRatio           float64       `mapstructure:"TraceSampleRatio"`
+	HealthCheckInterval        time.Duration `mapstructure:"HealthCheckInterval"`
+	ShutdownTimeout            time.Duration `mapstructure:"ShutdownTimeout"`
+	MigrateOnStartup           bool          `mapstructure:"MigrateOnStartup"`
+	AgeRatingPolicy            string        `mapstructure:"AgeRatingPolicy" validate:"oneof=block flag"`
+	UserAdminCacheTTL          time.Duration `mapstructure:"UserAdminCacheTTL"`
+	PaymentTarget              string        `mapstructure:"PaymentTarget"`
+	PaymentTimeout             time.Duration `mapstructure:"PaymentTimeout"`
+	UserAdminTarget            string        `mapstructure:"UserAdminTarget"`
+	UserAdminTimeout           time.Duration `mapstructure:"UserAdminTimeout"`
+	NotificationTarget         string        `mapstructure:"NotificationTarget"`
+	NotificationTimeout        time.Duration `mapstructure:"NotificationTimeout"`
+	GrpcClientTLS              bool          `mapstructure:"GrpcClientTLS"`
+	GrpcClientCAFile           string        `mapstructure:"GrpcClientCAFile"`
+	GrpcClientCertFile         string        `mapstructure:"GrpcClientCertFile"`
+	GrpcClientKeyFile          string        `mapstructure:"GrpcClientKeyFile"`
+	GrpcClientKeepaliveTime    time.Duration `mapstructure:"GrpcClientKeepaliveTime"`
+	GrpcClientKeepaliveTimeout time.Duration `mapstructure:"GrpcClientKeepaliveTimeout"`
+	GrpcClientMaxAttempts      int           `mapstructure:"GrpcClientMaxAttempts"`
+	GrpcClientInitialBackoff   time.Duration `mapstructure:"GrpcClientInitialBackoff"`
+	GrpcClientMaxBackoff       time.Duration `mapstructure:"GrpcClientMaxBackoff"`
+	PaymentRetryAttempts       int           `mapstructure:"PaymentRetryAttempts"`
+	PaymentRetryBackoff        time.Duration `mapstructure:"PaymentRetryBackoff"`
+	PaymentRetryMaxBackoff     time.Duration `mapstructure:"PaymentRetryMaxBackoff"`
+	PaymentBreakerThreshold    int           `mapstructure:"PaymentBreakerThreshold"`
+	PaymentBreakerCooldown     time.Duration `mapstructure:"PaymentBreakerCooldown"`
+	PaymentReconcileInterval   time.Duration `mapstructure:"PaymentReconcileInterval"`
+	PaymentStaleAfter          time.Duration `mapstructure:"PaymentStaleAfter"`
+	PaymentCallbacks           bool          `mapstructure:"PaymentCallbacks"`
+	PaymentCallbackSecret      string        `mapstructure:"PaymentCallbackSecret"`
+	PaymentCallbackMaxAge      time.Duration `mapstructure:"PaymentCallbackMaxAge"`
+	GrpcServerCertFile         string        `mapstructure:"GrpcServerCertFile"`
+	GrpcServerKeyFile          string        `mapstructure:"GrpcServerKeyFile"`
+	GrpcServerClientCAFile     string        `mapstructure:"GrpcServerClientCAFile"`
+	GrpcServerClientAuth       string        `mapstructure:"GrpcServerClientAuth" validate:"oneof=none optional require"`
+	GrpcServerTrustedClients   string        `mapstructure:"GrpcServerTrustedClients"`
+	GrpcServerTLSReload        time.Duration `mapstructure:"GrpcServerTLSReload"`
 }
 
 var envs = []string{
-	"DBHOST", "DBNAME", "DBUSER", "DBPORT", "DBPASSWORD", "GRPCPORT", "GrpcNotificationPort", "GrpcUserAdminServicePort", "RedisPort", "REDISHOST", "GrpcPaymentPort",
+	"DBHOST", "DBNAME", "DBUSER", "DBPORT", "DBPASSWORD", "GRPCPORT", "GrpcNotificationPort", "GrpcUserAdminServicePort", "RedisPort", "REDISHOST", "GrpcPaymentPort", "TicketSigningKey",
+	"CheckInOpensBefore", "CheckInClosesAfter", "JWTSecret", "JWKSFile", "JWTIssuer", "JWTAudience",
+	"RPCTimeout", "LogLevel", "LogFormat", "MetricsPort",
+	"TraceExporter", "OTLPEndpoint", "OTLPInsecure", "TraceSampleRatio",
+	"HealthCheckInterval", "ShutdownTimeout", "MigrateOnStartup", "AgeRatingPolicy",
+	"UserAdminCacheTTL", "PaymentTarget", "PaymentTimeout", "UserAdminTarget", "UserAdminTimeout",
+	"NotificationTarget", "NotificationTimeout", "GrpcClientTLS", "GrpcClientCAFile", "GrpcClientCertFile",
+	"GrpcClientKeyFile", "GrpcClientKeepaliveTime", "GrpcClientKeepaliveTimeout", "GrpcClientMaxAttempts",
+	"GrpcClientInitialBackoff", "GrpcClientMaxBackoff", "PaymentRetryAttempts", "PaymentRetryBackoff",
+	"PaymentRetryMaxBackoff", "PaymentBreakerThreshold", "PaymentBreakerCooldown", "PaymentReconcileInterval",
+	"PaymentStaleAfter", "PaymentCallbacks", "PaymentCallbackSecret", "PaymentCallbackMaxAge", "GrpcServerCertFile",
+	"GrpcServerKeyFile", "GrpcServerClientCAFile", "GrpcServerClientAuth", "GrpcServerTrustedClients",
+	"GrpcServerTLSReload",
+}
+
+var defaults = map[string]interface{}{
+	"CheckInOpensBefore":         45 * time.Minute,
+	"CheckInClosesAfter":         30 * time.Minute,
+	"RPCTimeout":                 10 * time.Second,
+	"LogLevel":                   "info",
+	"LogFormat":                  "json",
+	"MetricsPort":                "9090",
+	"TraceExporter":              "none",
+	"OTLPEndpoint":               "localhost:4317",
+	"OTLPInsecure":               true,
+	"TraceSampleRatio":           1.0,
+	"HealthCheckInterval":        10 * time.Second,
+	"ShutdownTimeout":            25 * time.Second,
+	"MigrateOnStartup":           true,
+	"AgeRatingPolicy":            "block",
+	"UserAdminCacheTTL":          time.Minute,
+	"PaymentTimeout":             5 * time.Second,
+	"UserAdminTimeout":           3 * time.Second,
+	"NotificationTimeout":        5 * time.Second,
+	"GrpcClientKeepaliveTimeout": 10 * time.Second,
+	"GrpcClientMaxAttempts":      3,
+	"GrpcClientInitialBackoff":   100 * time.Millisecond,
+	"GrpcClientMaxBackoff":       time.Second,
+	"PaymentRetryAttempts":       3,
+	"PaymentRetryBackoff":        200 * time.Millisecond,
+	"PaymentRetryMaxBackoff":     2 * time.Second,
+	"PaymentBreakerThreshold":    5,
+	"PaymentBreakerCooldown":     30 * time.Second,
+	"PaymentReconcileInterval":   time.Minute,
+	"PaymentStaleAfter":          10 * time.Minute,
+	"PaymentCallbacks":           true,
+	"PaymentCallbackMaxAge":      5 * time.Minute,
+	"GrpcServerClientAuth":       "optional",
+	"GrpcServerTLSReload":        30 * time.Second,
 }
 
 func LoadConfig() (Config, error) {
@@ -30,6 +130,9 @@ func LoadConfig() (Config, error) {
 	viper.AddConfigPath(".")
 	viper.SetConfigFile(".env")
 	viper.AutomaticEnv()
+	for key, value := range defaults {
+		viper.SetDefault(key, value)
+	}
 
 	if err := viper.ReadInConfig(); err != nil {
 		return cfg, fmt.Errorf("error reading config file: %w", err)
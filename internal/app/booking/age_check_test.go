@@ -0,0 +1,136 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/inter-communication/user_admin"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"gorm.io/gorm"
+)
+
+type fakeMovieRepo struct {
+	movies.Repository
+	certificates map[int]string
+}
+
+func (r fakeMovieRepo) GetMovieDetailsById(ctx context.Context, movieId int) (*movies.Movie, error) {
+	certificate, ok := r.certificates[movieId]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	movie := &movies.Movie{Certificate: certificate}
+	movie.ID = uint(movieId)
+	return movie, nil
+}
+
+const (
+	movieU = iota + 1
+	movieUA
+	movieA
+)
+
+var (
+	ageShowDate = time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	ageMovies   = fakeMovieRepo{certificates: map[int]string{
+		movieU:  movies.CertificateU,
+		movieUA: movies.CertificateUA,
+		movieA:  movies.CertificateA,
+	}}
+)
+
+func checkAgeOf(policy AgeRatingPolicy, dateOfBirth string, movieId int) (ageCheck, error) {
+	svc := &service{movieRepo: ageMovies, agePolicy: policy}
+	user := &user_admin.User{Id: 1, DateOfBirth: dateOfBirth, IsVerified: true}
+	return svc.checkAge(context.Background(), user, &theatres.Showtime{MovieID: movieId, ShowDate: ageShowDate})
+}
+
+func TestCheckAge(t *testing.T) {
+	const (
+		turns18OnShowDay = "2008-03-15"
+		turns18NextDay   = "2008-03-16"
+		child            = "2020-01-01"
+	)
+	tests := []struct {
+		name        string
+		movie       int
+		dateOfBirth string
+		policy      AgeRatingPolicy
+		want        string
+		wantAge     int
+		wantErr     bool
+	}{
+		{"U, child", movieU, child, AgeRatingBlock, AgeCheckNotRequired, -1, false},
+		{"U, no date of birth", movieU, "", AgeRatingBlock, AgeCheckNotRequired, -1, false},
+		{"UA, child", movieUA, child, AgeRatingBlock, AgeCheckNotRequired, -1, false},
+		{"UA, no date of birth", movieUA, "", AgeRatingBlock, AgeCheckNotRequired, -1, false},
+		{"A, 18 on the show day", movieA, turns18OnShowDay, AgeRatingBlock, AgeCheckPassed, 18, false},
+		{"A, 18 the day after the show", movieA, turns18NextDay, AgeRatingBlock, "", 0, true},
+		{"A, 18 the day after the show, flagged", movieA, turns18NextDay, AgeRatingFlag, AgeCheckFlagged, 17, false},
+		{"A, other date layout", movieA, "15/03/2008", AgeRatingBlock, AgeCheckPassed, 18, false},
+		{"A, no date of birth", movieA, "", AgeRatingBlock, "", 0, true},
+		{"A, no date of birth, flagged", movieA, " ", AgeRatingFlag, AgeCheckUnverified, -1, false},
+		{"A, unreadable date of birth", movieA, "March 2008", AgeRatingBlock, "", 0, true},
+		{"A, unreadable date of birth, flagged", movieA, "March 2008", AgeRatingFlag, AgeCheckUnverified, -1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check, err := checkAgeOf(tt.policy, tt.dateOfBirth, tt.movie)
+			if tt.wantErr {
+				if !apperrors.Is(err, apperrors.KindFailedPrecondition) {
+					t.Fatalf("checkAge = %v, want failed precondition", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkAge: %v", err)
+			}
+			if check.result != tt.want || check.certificate != ageMovies.certificates[tt.movie] {
+				t.Fatalf("checkAge = %+v, want %s for %s", check, tt.want, ageMovies.certificates[tt.movie])
+			}
+			switch {
+			case tt.wantAge < 0 && check.age != nil:
+				t.Errorf("age = %d, want none", *check.age)
+			case tt.wantAge >= 0 && (check.age == nil || *check.age != tt.wantAge):
+				t.Errorf("age = %v, want %d", check.age, tt.wantAge)
+			}
+		})
+	}
+}
+
+func TestCheckAgeRejectionMetadata(t *testing.T) {
+	_, err := checkAgeOf(AgeRatingBlock, "2010-01-01", movieA)
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) {
+		t.Fatalf("checkAge = %v, want an apperrors.Error", err)
+	}
+	if appErr.Metadata["certificate"] != movies.CertificateA || appErr.Metadata["minimum_age"] != "18" {
+		t.Fatalf("metadata = %v", appErr.Metadata)
+	}
+
+	if _, err := checkAgeOf(AgeRatingBlock, "2010-01-01", 99); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("unknown movie = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+func TestAgeOn(t *testing.T) {
+	dob := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		day  time.Time
+		want int
+	}{
+		{time.Date(2018, 2, 28, 0, 0, 0, 0, time.UTC), 17},
+		{time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), 18},
+		{time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), 20},
+		{time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range tests {
+		if got := ageOn(dob, tt.day); got != tt.want {
+			t.Errorf("ageOn(%s) = %d, want %d", tt.day.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
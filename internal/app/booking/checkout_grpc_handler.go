@@ -0,0 +1,55 @@
+package booking
+
+import (
+	"context"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/checkout"
+)
+
+type CheckoutGrpcHandler struct {
+	svc Service
+	checkout.UnimplementedCheckoutServiceServer
+}
+
+func NewCheckoutGrpcHandler(svc Service) CheckoutGrpcHandler {
+	return CheckoutGrpcHandler{
+		svc: svc,
+	}
+}
+
+func (h *CheckoutGrpcHandler) StartPayment(ctx context.Context, req *checkout.StartPaymentRequest) (*checkout.StartPaymentResponse, error) {
+	booking, err := h.svc.StartPayment(ctx, int(req.BookingId), int(req.PaymentMethodId))
+	if err != nil {
+		return nil, err
+	}
+	response := &checkout.StartPaymentResponse{
+		BookingId:     uint32(booking.BookingID),
+		PaymentStatus: booking.PaymentStatus,
+		OrderId:       booking.OrderID,
+	}
+	if booking.TransactionID != nil {
+		response.TransactionId = *booking.TransactionID
+	}
+	return response, nil
+}
+
+func (h *CheckoutGrpcHandler) PaymentResult(ctx context.Context, req *checkout.PaymentResultRequest) (*checkout.PaymentResultResponse, error) {
+	booking, applied, err := h.svc.HandlePaymentResult(ctx, PaymentResult{
+		BookingID:     int(req.BookingId),
+		TransactionID: req.TransactionId,
+		OrderID:       req.OrderId,
+		Outcome:       req.Outcome.String(),
+		Amount:        req.Amount,
+		Timestamp:     time.Unix(req.Timestamp, 0),
+		Signature:     req.Signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &checkout.PaymentResultResponse{
+		BookingId:     uint32(booking.BookingID),
+		PaymentStatus: booking.PaymentStatus,
+		Applied:       applied,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package booking
+
+const (
+	PaymentStatusPending = "Pending"
+	// The payment was started and its outcome isn't known yet.
+	PaymentStatusProcessing = "Processing"
+	PaymentStatusSuccess    = "Success"
+	PaymentStatusFailed     = "Failed"
+)
+
+// Payment callback outcomes, as named in checkout.PaymentOutcome.
+const (
+	OutcomeSucceeded = "SUCCEEDED"
+	OutcomeFailed    = "FAILED"
+)
+
+// Kinds of PaymentMismatch.
+const (
+	// The payment service and the booking disagree on whether it was paid.
+	MismatchStatus = "status_mismatch"
+	// Both say paid but for different amounts.
+	MismatchAmount = "amount_mismatch"
+	// The payment service has no record of the booking's transaction.
+	MismatchMissingPayment = "missing_payment"
+	// The booking is paid but has no transaction to check against.
+	MismatchNoTransaction = "no_transaction"
+	// The payment service returned an error for the transaction.
+	MismatchLookupFailed = "lookup_failed"
+	// A payment was sent but its outcome never came back, so the payment
+	// service may hold a payment that can't be looked up from here.
+	MismatchUnconfirmed = "unconfirmed_payment"
+)
+
+const (
+	AgeCheckNotRequired = "not_required"
+	AgeCheckPassed      = "passed"
+	// Under age, booked because the policy only flags.
+	AgeCheckFlagged = "flagged"
+	// No usable date of birth, booked because the policy only flags.
+	AgeCheckUnverified = "unverified"
+)
+
+// AgeRatingPolicy decides what happens when a user is too young for the
+// movie or their age can't be established.
+type AgeRatingPolicy string
+
+const (
+	AgeRatingBlock AgeRatingPolicy = "block"
+	AgeRatingFlag  AgeRatingPolicy = "flag"
+)
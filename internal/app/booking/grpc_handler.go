@@ -3,6 +3,8 @@ package booking
 import (
 	"context"
 
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+
 	"github.com/aparnasukesh/inter-communication/movie_booking"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -83,10 +85,17 @@ func (h *GrpcHandler) GetBookingByID(ctx context.Context, req *movie_booking.Get
 }
 
 func (h *GrpcHandler) ListBookingsByUser(ctx context.Context, req *movie_booking.ListBookingsByUserRequest) (*movie_booking.ListBookingsByUserResponse, error) {
-	bookings, err := h.svc.ListBookingsByUser(ctx, int(req.UserId))
+	page, err := pagination.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bookings, nextPageToken, err := h.svc.ListBookingsByUser(ctx, int(req.UserId), page)
 	if err != nil {
 		return nil, err
 	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	response := []*movie_booking.Booking{}
 	for _, booking := range bookings {
 		seats := make([]*movie_booking.BookingSeat, len(booking.BookingSeats))
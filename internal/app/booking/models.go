@@ -15,7 +15,15 @@ type Booking struct {
 	BookingDate   time.Time      `gorm:"type:timestamp;not null" json:"booking_date"`
 	TotalAmount   float64        `gorm:"type:decimal(10,2);not null" json:"total_amount"`
 	PaymentStatus string         `gorm:"type:varchar(50);not null" json:"payment_status"`
-	BookingSeats  []BookingSeat  `gorm:"foreignKey:BookingID" json:"booking_seats"`
+	// Outcome of the age rating check, see AgeCheck constants.
+	AgeCheck            string        `gorm:"type:varchar(20)" json:"age_check"`
+	AgeCheckCertificate string        `gorm:"type:varchar(4)" json:"age_check_certificate"`
+	AgeAtShow           *int          `json:"age_at_show"`
+	TransactionID       *int32        `json:"transaction_id"`
+	OrderID             string        `gorm:"type:varchar(100)" json:"order_id"`
+	PaymentStartedAt    *time.Time    `gorm:"type:timestamp" json:"payment_started_at"`
+	PaymentCheckedAt    *time.Time    `gorm:"type:timestamp" json:"payment_checked_at"`
+	BookingSeats        []BookingSeat `gorm:"foreignKey:BookingID" json:"booking_seats"`
 }
 
 type BookingSeat struct {
@@ -31,3 +39,114 @@ type CreateBookingRequest struct {
 	SeatIDs     []int   `json:"seat_ids"`
 	TotalAmount float64 `json:"total_amount"`
 }
+
+// PaymentResult is a payment callback. Outcome is the name of the
+// checkout.PaymentOutcome value.
+type PaymentResult struct {
+	BookingID     int
+	TransactionID int32
+	OrderID       string
+	Outcome       string
+	Amount        float64
+	Timestamp     time.Time
+	Signature     string
+}
+
+// ReconcileSummary counts what a reconciliation pass did with the bookings
+// stuck in Processing.
+type ReconcileSummary struct {
+	Checked     int
+	Paid        int
+	Failed      int
+	Pending     int
+	Unconfirmed int
+}
+
+// PaymentReport compares the bookings made in a window with the payment
+// service's records of them.
+type PaymentReport struct {
+	ID          uint              `gorm:"primaryKey;autoIncrement" json:"id"`
+	WindowStart time.Time         `gorm:"type:timestamp;not null" json:"window_start"`
+	WindowEnd   time.Time         `gorm:"type:timestamp;not null" json:"window_end"`
+	GeneratedAt time.Time         `gorm:"type:timestamp;not null" json:"generated_at"`
+	Checked     int               `gorm:"not null" json:"checked"`
+	Mismatched  int               `gorm:"not null" json:"mismatched"`
+	Mismatches  []PaymentMismatch `gorm:"foreignKey:ReportID" json:"mismatches"`
+}
+
+type PaymentMismatch struct {
+	ID            uint     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ReportID      uint     `gorm:"not null;index" json:"report_id"`
+	BookingID     uint     `gorm:"not null;index" json:"booking_id"`
+	TransactionID *int32   `json:"transaction_id"`
+	Kind          string   `gorm:"type:varchar(30);not null" json:"kind"`
+	BookingStatus string   `gorm:"type:varchar(50);not null" json:"booking_status"`
+	PaymentStatus string   `gorm:"type:varchar(50)" json:"payment_status"`
+	BookingAmount float64  `gorm:"type:decimal(10,2);not null" json:"booking_amount"`
+	PaymentAmount *float64 `gorm:"type:decimal(10,2)" json:"payment_amount"`
+}
+
+type Ticket struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	BookingID   uint      `gorm:"not null;uniqueIndex" json:"booking_id"`
+	ShowtimeID  uint      `gorm:"not null" json:"showtime_id"`
+	SeatNumbers string    `gorm:"type:text;not null" json:"seat_numbers"`
+	Payload     string    `gorm:"type:text;not null" json:"payload"`
+	ValidFrom   time.Time `gorm:"type:timestamp;not null" json:"valid_from"`
+	ValidUntil  time.Time `gorm:"type:timestamp;not null" json:"valid_until"`
+	IssuedAt    time.Time `gorm:"type:timestamp;not null" json:"issued_at"`
+}
+
+type TicketResponse struct {
+	Ticket      Ticket   `json:"ticket"`
+	SeatNumbers []string `json:"seat_numbers"`
+	QRCodePNG   []byte   `json:"qr_code_png"`
+}
+
+type Admission struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	BookingID  uint      `gorm:"not null;uniqueIndex:idx_admission_booking_seat" json:"booking_id"`
+	SeatID     uint      `gorm:"not null;uniqueIndex:idx_admission_booking_seat" json:"seat_id"`
+	SeatNumber string    `gorm:"type:varchar(10);not null" json:"seat_number"`
+	ShowtimeID uint      `gorm:"not null;index" json:"showtime_id"`
+	TheaterID  uint      `gorm:"not null" json:"theater_id"`
+	DeviceID   string    `gorm:"type:varchar(100);not null" json:"device_id"`
+	AdmittedAt time.Time `gorm:"type:timestamp;not null" json:"admitted_at"`
+}
+
+type CheckInWindow struct {
+	OpensBefore time.Duration
+	ClosesAfter time.Duration
+}
+
+// Around returns when check-in opens and closes for a show starting at
+// showStart.
+func (w CheckInWindow) Around(showStart time.Time) (opens, closes time.Time) {
+	return showStart.Add(-w.OpensBefore), showStart.Add(w.ClosesAfter)
+}
+
+type CheckInRequest struct {
+	Payload   string `json:"payload"`
+	TheaterID int    `json:"theater_id"`
+	DeviceID  string `json:"device_id"`
+}
+
+type CheckInRejection string
+
+const (
+	CheckInRejectionInvalidTicket    CheckInRejection = "INVALID_TICKET"
+	CheckInRejectionBookingNotFound  CheckInRejection = "BOOKING_NOT_FOUND"
+	CheckInRejectionBookingNotPaid   CheckInRejection = "BOOKING_NOT_PAID"
+	CheckInRejectionWrongTheater     CheckInRejection = "WRONG_THEATER"
+	CheckInRejectionOutsideWindow    CheckInRejection = "OUTSIDE_CHECK_IN_WINDOW"
+	CheckInRejectionAlreadyCheckedIn CheckInRejection = "ALREADY_CHECKED_IN"
+)
+
+type CheckInResult struct {
+	Admitted        bool             `json:"admitted"`
+	RejectionReason CheckInRejection `json:"rejection_reason,omitempty"`
+	Message         string           `json:"message"`
+	BookingID       uint             `json:"booking_id"`
+	SeatNumbers     []string         `json:"seat_numbers"`
+	CheckedInAt     time.Time        `json:"checked_in_at"`
+}
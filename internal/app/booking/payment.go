@@ -0,0 +1,244 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/paycallback"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/resilience"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How many stuck bookings one reconciliation pass looks at.
+const reconcileBatchSize = 100
+
+// StartPayment charges the booking through the payment service. The booking
+// is Processing while the call is in flight. It only goes back to Pending,
+// so the user can try again, when the call certainly didn't take a payment.
+// After any other failure, e.g. a timeout or a dropped connection, the
+// payment may still have gone through, so the booking stays Processing
+// until the payment callback settles it.
+func (s *service) StartPayment(ctx context.Context, bookingId int, paymentMethodId int) (*Booking, error) {
+	if paymentMethodId <= 0 {
+		return nil, apperrors.InvalidArgument("payment method id is required")
+	}
+	booking, err := s.repo.GetBookingByID(ctx, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := auth.RequireUser(ctx, booking.UserID); err != nil {
+		return nil, fmt.Errorf("unauthorized: only the booking owner can pay for booking %d: %w", bookingId, err)
+	}
+	claimed, err := s.repo.ClaimPayment(ctx, bookingId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		// Already started or settled, report where it is.
+		return s.repo.GetBookingByID(ctx, bookingId)
+	}
+
+	resp, err := s.paymentClient.ProcessPayment(ctx, &payment.ProcessPaymentRequest{
+		BookingId:       int32(booking.BookingID),
+		UserId:          int32(booking.UserID),
+		Amount:          booking.TotalAmount,
+		PaymentMethodId: int32(paymentMethodId),
+	})
+	if err != nil {
+		if !paymentNotTaken(err) {
+			logger.FromContext(ctx).Warn("payment outcome unknown, booking stays processing", "booking_id", bookingId, "error", err)
+			return nil, fmt.Errorf("payment for booking %d was sent but not confirmed, it is settled when the payment service reports back: %w", bookingId, err)
+		}
+		// The request may have timed out, the booking still has to go back.
+		if releaseErr := s.repo.ReleasePayment(context.WithoutCancel(ctx), bookingId); releaseErr != nil {
+			logger.FromContext(ctx).Error("failed to release payment claim", "booking_id", bookingId, "error", releaseErr)
+		}
+		if errors.Is(err, resilience.ErrOpen) {
+			return nil, status.Errorf(codes.Unavailable, "payments are unavailable right now, booking %d is still reserved: %v", bookingId, err)
+		}
+		return nil, fmt.Errorf("failed to start payment for booking %d: %w", bookingId, err)
+	}
+	transaction := resp.GetTransaction()
+	if err := s.repo.SetPaymentTransaction(ctx, bookingId, transaction.GetTransactionId(), transaction.GetOrderId()); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info("payment started", "booking_id", bookingId, "transaction_id", transaction.GetTransactionId())
+	booking.PaymentStatus = PaymentStatusProcessing
+	if settled := settledStatus(transaction.GetStatus()); settled != "" {
+		if _, err := s.settlePayment(ctx, booking, settled); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.GetBookingByID(ctx, bookingId)
+}
+
+// ReconcilePayments settles bookings that have been Processing for longer
+// than staleAfter, normally because a payment callback was lost. Bookings
+// without a transaction can't be looked up, since the payment service only
+// finds transactions by id, and releasing them could charge the user twice.
+// They are left for the payment callback, which matches on the booking id,
+// and counted as unconfirmed. The pass stops early when the payment service
+// is unavailable.
+func (s *service) ReconcilePayments(ctx context.Context, staleAfter time.Duration) (ReconcileSummary, error) {
+	var summary ReconcileSummary
+	now := time.Now()
+	bookings, err := s.repo.ListStalePayments(ctx, now.Add(-staleAfter), reconcileBatchSize)
+	if err != nil {
+		return summary, err
+	}
+	for i := range bookings {
+		booking := &bookings[i]
+		summary.Checked++
+		if booking.TransactionID == nil {
+			logger.FromContext(ctx).Warn("payment has no transaction to check, waiting for its callback", "booking_id", booking.BookingID, "started_at", booking.PaymentStartedAt)
+			if err := s.repo.TouchPaymentCheck(ctx, int(booking.BookingID), now); err != nil {
+				return summary, err
+			}
+			summary.Unconfirmed++
+			s.metrics.PaymentReconciled("unconfirmed")
+			continue
+		}
+		resp, err := s.paymentClient.GetTransactionStatus(ctx, &payment.GetTransactionStatusRequest{TransactionId: *booking.TransactionID})
+		if status.Code(err) == codes.Unavailable {
+			return summary, fmt.Errorf("payment service unavailable, %d bookings left for the next pass: %w", len(bookings)-i, err)
+		}
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to query payment status", "booking_id", booking.BookingID, "transaction_id", *booking.TransactionID, "error", err)
+			if err := s.repo.TouchPaymentCheck(ctx, int(booking.BookingID), now); err != nil {
+				return summary, err
+			}
+			continue
+		}
+		switch settled := settledStatus(resp.GetStatus()); settled {
+		case "":
+			if err := s.repo.TouchPaymentCheck(ctx, int(booking.BookingID), now); err != nil {
+				return summary, err
+			}
+			summary.Pending++
+			s.metrics.PaymentReconciled("pending")
+		default:
+			if _, err := s.settlePayment(ctx, booking, settled); err != nil {
+				return summary, err
+			}
+			if settled == PaymentStatusSuccess {
+				summary.Paid++
+				s.metrics.PaymentReconciled("paid")
+			} else {
+				summary.Failed++
+				s.metrics.PaymentReconciled("failed")
+			}
+		}
+	}
+	return summary, nil
+}
+
+// HandlePaymentResult applies a signed callback from the payment service. The
+// transaction and, for successful payments, the amount must match the
+// booking. Redeliveries of an applied result succeed without changing
+// anything and report false.
+func (s *service) HandlePaymentResult(ctx context.Context, result PaymentResult) (*Booking, bool, error) {
+	if s.callbacks == nil {
+		return nil, false, apperrors.FailedPrecondition("payment callbacks are not configured")
+	}
+	var status string
+	switch result.Outcome {
+	case OutcomeSucceeded:
+		status = PaymentStatusSuccess
+	case OutcomeFailed:
+		status = PaymentStatusFailed
+	default:
+		return nil, false, apperrors.InvalidArgument("invalid payment outcome %q", result.Outcome)
+	}
+	err := s.callbacks.Verify(paycallback.Result{
+		BookingID:     uint32(result.BookingID),
+		TransactionID: result.TransactionID,
+		OrderID:       result.OrderID,
+		Outcome:       result.Outcome,
+		Amount:        result.Amount,
+		Timestamp:     result.Timestamp,
+	}, result.Signature, time.Now())
+	if err != nil {
+		logger.FromContext(ctx).Warn("rejected payment callback", "booking_id", result.BookingID, "transaction_id", result.TransactionID, "error", err)
+		return nil, false, apperrors.Unauthenticated("%v", err)
+	}
+
+	booking, err := s.repo.GetBookingByID(ctx, result.BookingID)
+	if err != nil {
+		return nil, false, err
+	}
+	if booking.TransactionID != nil && *booking.TransactionID != result.TransactionID {
+		return nil, false, apperrors.FailedPrecondition("transaction %d does not belong to booking %d", result.TransactionID, result.BookingID)
+	}
+	if status == PaymentStatusSuccess && !amountsMatch(result.Amount, booking.TotalAmount) {
+		logger.FromContext(ctx).Error("payment amount does not match booking", "booking_id", result.BookingID, "transaction_id", result.TransactionID, "paid", result.Amount, "total", booking.TotalAmount)
+		return nil, false, apperrors.FailedPrecondition("paid amount %.2f does not match the booking total %.2f", result.Amount, booking.TotalAmount)
+	}
+	if err := checkSettlement(booking, status); err != nil || isSettled(booking.PaymentStatus) {
+		return booking, false, err
+	}
+
+	applied, err := s.repo.RecordPaymentResult(ctx, result.BookingID, status, result.TransactionID, result.OrderID)
+	if err != nil {
+		return nil, false, err
+	}
+	if applied {
+		s.countSettlement(booking, status)
+		logger.FromContext(ctx).Info("payment result applied", "booking_id", result.BookingID, "transaction_id", result.TransactionID, "status", status)
+	}
+	current, err := s.repo.GetBookingByID(ctx, result.BookingID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !applied {
+		// Settled by another call in the meantime.
+		if err := checkSettlement(current, status); err != nil {
+			return nil, false, err
+		}
+	}
+	return current, applied, nil
+}
+
+// checkSettlement rejects a result that contradicts how the booking was
+// already settled.
+func checkSettlement(booking *Booking, status string) error {
+	if isSettled(booking.PaymentStatus) && !strings.EqualFold(booking.PaymentStatus, status) {
+		return apperrors.Conflict("booking %d is already settled as %s", booking.BookingID, booking.PaymentStatus)
+	}
+	return nil
+}
+
+// paymentNotTaken reports whether err proves that ProcessPayment took no
+// payment: the breaker never sent it, or the payment service rejected it.
+func paymentNotTaken(err error) bool {
+	return errors.Is(err, resilience.ErrOpen) || status.Code(err) == codes.InvalidArgument
+}
+
+func isSettled(paymentStatus string) bool {
+	return strings.EqualFold(paymentStatus, PaymentStatusSuccess) || strings.EqualFold(paymentStatus, PaymentStatusFailed)
+}
+
+func amountsMatch(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+// settledStatus maps a payment service status to the final booking status,
+// or "" while the payment is still open.
+func settledStatus(paymentStatus string) string {
+	switch strings.ToLower(paymentStatus) {
+	case "success", "succeeded", "paid", "captured":
+		return PaymentStatusSuccess
+	case "failed", "failure", "cancelled", "canceled":
+		return PaymentStatusFailed
+	default:
+		return ""
+	}
+}
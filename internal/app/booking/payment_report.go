@@ -0,0 +1,105 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PaymentReport compares every booking made in [from, to) with the payment
+// service and stores the bookings that disagree with it. The report is
+// stopped, and nothing is stored, if the payment service is unavailable.
+//
+// The check only runs from bookings to payments. The payment service finds
+// transactions by id and can't list them, so a payment whose booking never
+// recorded its transaction isn't found from here. Such bookings are still
+// Processing and are reported as unconfirmed, which covers payments started
+// by this service; anything else needs a report from the payment side.
+func (s *service) PaymentReport(ctx context.Context, from, to time.Time) (*PaymentReport, error) {
+	if !from.Before(to) {
+		return nil, apperrors.InvalidArgument("report window start must be before its end")
+	}
+	report := &PaymentReport{
+		WindowStart: from,
+		WindowEnd:   to,
+	}
+	err := s.repo.FindBookingsInWindow(ctx, from, to, func(bookings []Booking) error {
+		for i := range bookings {
+			mismatch, err := s.comparePayment(ctx, &bookings[i])
+			if err != nil {
+				return err
+			}
+			report.Checked++
+			if mismatch != nil {
+				report.Mismatches = append(report.Mismatches, *mismatch)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Mismatched = len(report.Mismatches)
+	report.GeneratedAt = time.Now()
+	if err := s.repo.CreatePaymentReport(ctx, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// comparePayment returns how the booking disagrees with the payment service,
+// or nil if it doesn't.
+func (s *service) comparePayment(ctx context.Context, booking *Booking) (*PaymentMismatch, error) {
+	mismatch := &PaymentMismatch{
+		BookingID:     booking.BookingID,
+		TransactionID: booking.TransactionID,
+		BookingStatus: booking.PaymentStatus,
+		BookingAmount: booking.TotalAmount,
+	}
+	if booking.TransactionID == nil {
+		switch {
+		case strings.EqualFold(booking.PaymentStatus, PaymentStatusSuccess):
+			mismatch.Kind = MismatchNoTransaction
+		case strings.EqualFold(booking.PaymentStatus, PaymentStatusProcessing):
+			mismatch.Kind = MismatchUnconfirmed
+		default:
+			return nil, nil
+		}
+		return mismatch, nil
+	}
+
+	resp, err := s.paymentClient.GetTransactionStatus(ctx, &payment.GetTransactionStatusRequest{TransactionId: *booking.TransactionID})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		mismatch.Kind = MismatchMissingPayment
+		return mismatch, nil
+	case codes.Unavailable:
+		return nil, fmt.Errorf("payment service unavailable: %w", err)
+	default:
+		mismatch.Kind = MismatchLookupFailed
+		mismatch.PaymentStatus = status.Convert(err).Message()
+		return mismatch, nil
+	}
+
+	paymentAmount := resp.GetAmount()
+	mismatch.PaymentStatus = resp.GetStatus()
+	mismatch.PaymentAmount = &paymentAmount
+	settled := settledStatus(resp.GetStatus())
+	switch {
+	case settled == "" && isSettled(booking.PaymentStatus),
+		settled != "" && !strings.EqualFold(booking.PaymentStatus, settled):
+		mismatch.Kind = MismatchStatus
+	case settled == PaymentStatusSuccess && !amountsMatch(booking.TotalAmount, paymentAmount):
+		mismatch.Kind = MismatchAmount
+	default:
+		return nil, nil
+	}
+	return mismatch, nil
+}
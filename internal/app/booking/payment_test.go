@@ -0,0 +1,122 @@
+package booking
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/paycallback"
+)
+
+const callbackSecret = "test-callback-secret"
+
+// RecordPaymentResult settles a pending or processing booking once.
+func (r *fakeRepo) RecordPaymentResult(ctx context.Context, bookingId int, status string, transactionId int32, orderId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	booking, ok := r.bookings[uint(bookingId)]
+	if !ok || isSettled(booking.PaymentStatus) {
+		return false, nil
+	}
+	booking.PaymentStatus = status
+	if booking.TransactionID == nil {
+		booking.TransactionID = &transactionId
+	}
+	r.bookings[uint(bookingId)] = booking
+	r.settlements++
+	return true, nil
+}
+
+func signedResult(bookingId int, outcome string, amount float64, at time.Time) PaymentResult {
+	result := PaymentResult{
+		BookingID:     bookingId,
+		TransactionID: 900,
+		OrderID:       "order-1",
+		Outcome:       outcome,
+		Amount:        amount,
+		Timestamp:     at,
+	}
+	result.Signature = paycallback.Sign([]byte(callbackSecret), paycallback.Result{
+		BookingID:     uint32(result.BookingID),
+		TransactionID: result.TransactionID,
+		OrderID:       result.OrderID,
+		Outcome:       result.Outcome,
+		Amount:        result.Amount,
+		Timestamp:     result.Timestamp,
+	})
+	return result
+}
+
+func newCallbackService(t *testing.T) (*service, *fakeRepo) {
+	t.Helper()
+	callbacks, err := paycallback.NewVerifier(callbackSecret, time.Minute)
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	repo := &fakeRepo{bookings: map[uint]Booking{
+		unpaidBookingID: {BookingID: unpaidBookingID, ShowtimeID: showtimeID, TotalAmount: 300, PaymentStatus: PaymentStatusProcessing},
+	}}
+	return &service{repo: repo, callbacks: callbacks, metrics: metrics.New()}, repo
+}
+
+func TestHandlePaymentResultReplay(t *testing.T) {
+	svc, repo := newCallbackService(t)
+	result := signedResult(unpaidBookingID, OutcomeSucceeded, 300, time.Unix(time.Now().Unix(), 0))
+
+	booking, applied, err := svc.HandlePaymentResult(context.Background(), result)
+	if err != nil || !applied || booking.PaymentStatus != PaymentStatusSuccess {
+		t.Fatalf("HandlePaymentResult: %+v, %t, %v", booking, applied, err)
+	}
+	// The same signed request again is accepted without settling twice.
+	booking, applied, err = svc.HandlePaymentResult(context.Background(), result)
+	if err != nil || applied || booking.PaymentStatus != PaymentStatusSuccess {
+		t.Fatalf("replayed HandlePaymentResult: %+v, %t, %v", booking, applied, err)
+	}
+	if repo.settlements != 1 {
+		t.Fatalf("booking settled %d times, want once", repo.settlements)
+	}
+}
+
+func TestHandlePaymentResultRejected(t *testing.T) {
+	now := time.Unix(time.Now().Unix(), 0)
+	tests := []struct {
+		name   string
+		result func() PaymentResult
+		want   apperrors.Kind
+	}{
+		{"tampered amount", func() PaymentResult {
+			result := signedResult(unpaidBookingID, OutcomeSucceeded, 300, now)
+			result.Amount = 1
+			return result
+		}, apperrors.KindUnauthenticated},
+		{"tampered outcome", func() PaymentResult {
+			result := signedResult(unpaidBookingID, OutcomeFailed, 300, now)
+			result.Outcome = OutcomeSucceeded
+			return result
+		}, apperrors.KindUnauthenticated},
+		{"stale", func() PaymentResult {
+			return signedResult(unpaidBookingID, OutcomeSucceeded, 300, now.Add(-time.Hour))
+		}, apperrors.KindUnauthenticated},
+		{"short payment", func() PaymentResult {
+			return signedResult(unpaidBookingID, OutcomeSucceeded, 299, now)
+		}, apperrors.KindFailedPrecondition},
+		{"unknown outcome", func() PaymentResult {
+			return signedResult(unpaidBookingID, "REFUNDED", 300, now)
+		}, apperrors.KindInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newCallbackService(t)
+			_, _, err := svc.HandlePaymentResult(context.Background(), tt.result())
+			if got := apperrors.KindOf(err); got != tt.want {
+				t.Fatalf("HandlePaymentResult error = %v, want kind %v", err, tt.want)
+			}
+			if repo.settlements != 0 || !strings.EqualFold(repo.bookings[unpaidBookingID].PaymentStatus, PaymentStatusProcessing) {
+				t.Fatalf("rejected callback settled the booking: %+v", repo.bookings[unpaidBookingID])
+			}
+		})
+	}
+}
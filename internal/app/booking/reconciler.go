@@ -0,0 +1,55 @@
+package booking
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+)
+
+// PaymentReconciler runs ReconcilePayments every interval. Settling is
+// conditional on the booking still being Processing, so several replicas
+// can run it at once.
+type PaymentReconciler struct {
+	svc        Service
+	interval   time.Duration
+	staleAfter time.Duration
+	logger     *slog.Logger
+}
+
+func NewPaymentReconciler(svc Service, interval, staleAfter time.Duration, logger *slog.Logger) *PaymentReconciler {
+	return &PaymentReconciler{
+		svc:        svc,
+		interval:   interval,
+		staleAfter: staleAfter,
+		logger:     logger.With("worker", "payment_reconciler"),
+	}
+}
+
+// Run reconciles every interval until ctx is done.
+func (r *PaymentReconciler) Run(ctx context.Context) {
+	ctx = logger.WithContext(ctx, r.logger)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reconcile(ctx)
+		}
+	}
+}
+
+func (r *PaymentReconciler) reconcile(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.interval)
+	defer cancel()
+	summary, err := r.svc.ReconcilePayments(ctx, r.staleAfter)
+	if err != nil {
+		r.logger.Warn("payment reconciliation incomplete", "error", err, "checked", summary.Checked)
+	}
+	if summary.Checked > 0 {
+		r.logger.Info("payments reconciled", "checked", summary.Checked, "paid", summary.Paid, "failed", summary.Failed, "pending", summary.Pending, "unconfirmed", summary.Unconfirmed)
+	}
+}
@@ -2,8 +2,11 @@ package booking
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
 	"gorm.io/gorm"
 )
 
@@ -11,14 +14,37 @@ type repository struct {
 	db *gorm.DB
 }
 
+var bookingListSpec = pagination.Spec[Booking]{
+	Fields: map[string]pagination.Field[Booking]{
+		"booking_date":   {Column: "booking_date", Type: pagination.Time, Value: func(b Booking) interface{} { return b.BookingDate }},
+		"total_amount":   {Column: "total_amount", Type: pagination.Float, Value: func(b Booking) interface{} { return b.TotalAmount }},
+		"payment_status": {Column: "payment_status", Type: pagination.String, Value: func(b Booking) interface{} { return b.PaymentStatus }},
+		"showtime_id":    {Column: "showtime_id", Type: pagination.Int, Value: func(b Booking) interface{} { return b.ShowtimeID }},
+	},
+	DefaultOrder: "booking_date desc",
+	ID:           pagination.Field[Booking]{Column: "booking_id", Type: pagination.Int, Value: func(b Booking) interface{} { return b.BookingID }},
+}
+
 type Repository interface {
 	CreateBooking(ctx context.Context, booking *Booking) error
 	CreateBookingSeats(ctx context.Context, bookingSeats []BookingSeat) error
 	GetBookingByID(ctx context.Context, bookingId int) (*Booking, error)
-	ListBookingsByUser(ctx context.Context, userId int) ([]Booking, error)
+	ListBookingsByUser(ctx context.Context, userId int, page pagination.Request) (*pagination.Page[Booking], error)
 	DeleteBookingByBookingID(ctx context.Context, bookingId int) error
-	UpdateBookingStatusByBookingID(ctx context.Context, bookingId int, status string) error
+	UpdateBookingStatusByBookingID(ctx context.Context, bookingId int, status string) (bool, error)
+	RecordPaymentResult(ctx context.Context, bookingId int, status string, transactionId int32, orderId string) (bool, error)
+	ClaimPayment(ctx context.Context, bookingId int, startedAt time.Time) (bool, error)
+	ReleasePayment(ctx context.Context, bookingId int) error
+	SetPaymentTransaction(ctx context.Context, bookingId int, transactionId int32, orderId string) error
+	ListStalePayments(ctx context.Context, startedBefore time.Time, limit int) ([]Booking, error)
+	TouchPaymentCheck(ctx context.Context, bookingId int, checkedAt time.Time) error
+	FindBookingsInWindow(ctx context.Context, from, to time.Time, fn func(bookings []Booking) error) error
+	CreatePaymentReport(ctx context.Context, report *PaymentReport) error
 	DeleteBookingSeats(ctx context.Context, bookingId int) error
+	CreateTicket(ctx context.Context, ticket *Ticket) error
+	GetTicketByBookingID(ctx context.Context, bookingId int) (*Ticket, error)
+	GetAdmissionsByBookingID(ctx context.Context, bookingId int) ([]Admission, error)
+	CreateAdmissions(ctx context.Context, admissions []Admission) error
 }
 
 func NewRepository(db *gorm.DB) Repository {
@@ -28,14 +54,14 @@ func NewRepository(db *gorm.DB) Repository {
 }
 
 func (r *repository) CreateBooking(ctx context.Context, booking *Booking) error {
-	if err := r.db.Create(&booking).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&booking).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (r *repository) CreateBookingSeats(ctx context.Context, bookingSeats []BookingSeat) error {
-	if err := r.db.Create(&bookingSeats).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&bookingSeats).Error; err != nil {
 		return err
 	}
 	return nil
@@ -43,47 +69,161 @@ func (r *repository) CreateBookingSeats(ctx context.Context, bookingSeats []Book
 
 func (r *repository) GetBookingByID(ctx context.Context, bookingId int) (*Booking, error) {
 	booking := &Booking{}
-	res := r.db.Preload("BookingSeats").Where("booking_id = ?", bookingId).First(&booking)
+	res := r.db.WithContext(ctx).Preload("BookingSeats").Where("booking_id = ?", bookingId).First(&booking)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("no booking found with id %d", bookingId)
+			return nil, apperrors.NotFound("no booking found with id %d: %w", bookingId, res.Error)
 		}
 		return nil, res.Error
 	}
 	return booking, nil
 }
 
-func (r *repository) ListBookingsByUser(ctx context.Context, userId int) ([]Booking, error) {
-	booking := []Booking{}
-	res := r.db.Preload("BookingSeats").Where("user_id = ?", userId).Find(&booking)
-	if res.Error != nil {
-		if res.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("no booking found with user id %d", userId)
-		}
-		return nil, res.Error
-	}
-	return booking, nil
+func (r *repository) ListBookingsByUser(ctx context.Context, userId int, page pagination.Request) (*pagination.Page[Booking], error) {
+	return pagination.List(r.db.WithContext(ctx).Preload("BookingSeats").Where("user_id = ?", userId), bookingListSpec, page)
 }
 func (r *repository) DeleteBookingByBookingID(ctx context.Context, bookingId int) error {
-	if err := r.db.Where("booking_id = ?", bookingId).Delete(&Booking{}).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("booking_id = ?", bookingId).Delete(&Booking{}).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *repository) UpdateBookingStatusByBookingID(ctx context.Context, bookingId int, status string) error {
+// UpdateBookingStatusByBookingID settles a pending or processing booking. It
+// reports false when the booking was already settled.
+func (r *repository) UpdateBookingStatusByBookingID(ctx context.Context, bookingId int, status string) (bool, error) {
 	booking := Booking{}
 
-	err := r.db.Model(&booking).Where("booking_id = ? AND payment_status ILIKE ?", bookingId, "Pending").Update("payment_status", status).Error
+	res := r.db.WithContext(ctx).Model(&booking).Where("booking_id = ? AND lower(payment_status) IN ?", bookingId, []string{"pending", "processing"}).Update("payment_status", status)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+// RecordPaymentResult settles the booking like UpdateBookingStatusByBookingID
+// and fills in the transaction when the payment wasn't started here.
+func (r *repository) RecordPaymentResult(ctx context.Context, bookingId int, status string, transactionId int32, orderId string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&Booking{}).
+		Where("booking_id = ? AND lower(payment_status) IN ?", bookingId, []string{"pending", "processing"}).
+		Updates(map[string]interface{}{
+			"payment_status": status,
+			"transaction_id": gorm.Expr("COALESCE(transaction_id, ?)", transactionId),
+			"order_id":       gorm.Expr("COALESCE(NULLIF(order_id, ''), ?)", orderId),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ClaimPayment moves a pending booking to Processing. It reports false when
+// the booking isn't pending, e.g. because another call already started its
+// payment.
+func (r *repository) ClaimPayment(ctx context.Context, bookingId int, startedAt time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&Booking{}).
+		Where("booking_id = ? AND payment_status ILIKE ?", bookingId, PaymentStatusPending).
+		Updates(map[string]interface{}{
+			"payment_status":     PaymentStatusProcessing,
+			"payment_started_at": startedAt,
+			"payment_checked_at": nil,
+			"transaction_id":     nil,
+			"order_id":           nil,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ReleasePayment returns a Processing booking without a transaction to
+// Pending so its payment can be started again.
+func (r *repository) ReleasePayment(ctx context.Context, bookingId int) error {
+	return r.db.WithContext(ctx).Model(&Booking{}).
+		Where("booking_id = ? AND payment_status = ? AND transaction_id IS NULL", bookingId, PaymentStatusProcessing).
+		Updates(map[string]interface{}{
+			"payment_status":     PaymentStatusPending,
+			"payment_started_at": nil,
+		}).Error
+}
+
+func (r *repository) SetPaymentTransaction(ctx context.Context, bookingId int, transactionId int32, orderId string) error {
+	return r.db.WithContext(ctx).Model(&Booking{}).
+		Where("booking_id = ? AND payment_status = ?", bookingId, PaymentStatusProcessing).
+		Updates(map[string]interface{}{
+			"transaction_id": transactionId,
+			"order_id":       orderId,
+		}).Error
+}
+
+// ListStalePayments returns Processing bookings started before
+// startedBefore that haven't been checked since then, oldest first.
+func (r *repository) ListStalePayments(ctx context.Context, startedBefore time.Time, limit int) ([]Booking, error) {
+	var bookings []Booking
+	err := r.db.WithContext(ctx).Preload("BookingSeats").
+		Where("payment_status = ? AND payment_started_at < ?", PaymentStatusProcessing, startedBefore).
+		Where("payment_checked_at IS NULL OR payment_checked_at < ?", startedBefore).
+		Order("payment_started_at").
+		Limit(limit).
+		Find(&bookings).Error
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return bookings, nil
+}
 
-	return nil
+// FindBookingsInWindow calls fn with batches of the bookings made in
+// [from, to), including deleted ones.
+func (r *repository) FindBookingsInWindow(ctx context.Context, from, to time.Time, fn func(bookings []Booking) error) error {
+	var batch []Booking
+	return r.db.WithContext(ctx).Unscoped().
+		Where("booking_date >= ? AND booking_date < ?", from, to).
+		FindInBatches(&batch, 200, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
+}
+
+func (r *repository) CreatePaymentReport(ctx context.Context, report *PaymentReport) error {
+	return r.db.WithContext(ctx).Create(report).Error
+}
+
+func (r *repository) TouchPaymentCheck(ctx context.Context, bookingId int, checkedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&Booking{}).Where("booking_id = ?", bookingId).Update("payment_checked_at", checkedAt).Error
 }
 func (r *repository) DeleteBookingSeats(ctx context.Context, bookingId int) error {
-	if err := r.db.Where("booking_id = ?", bookingId).Delete(&BookingSeat{}).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("booking_id = ?", bookingId).Delete(&BookingSeat{}).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+func (r *repository) CreateTicket(ctx context.Context, ticket *Ticket) error {
+	if err := r.db.WithContext(ctx).Create(ticket).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *repository) GetTicketByBookingID(ctx context.Context, bookingId int) (*Ticket, error) {
+	ticket := &Ticket{}
+	if err := r.db.WithContext(ctx).Where("booking_id = ?", bookingId).First(ticket).Error; err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+func (r *repository) GetAdmissionsByBookingID(ctx context.Context, bookingId int) ([]Admission, error) {
+	admissions := []Admission{}
+	if err := r.db.WithContext(ctx).Where("booking_id = ?", bookingId).Find(&admissions).Error; err != nil {
+		return nil, err
+	}
+	return admissions, nil
+}
+
+// CreateAdmissions inserts all admissions in one statement, so it admits
+// every seat or none. It fails if any seat of the booking was already
+// admitted.
+func (r *repository) CreateAdmissions(ctx context.Context, admissions []Admission) error {
+	return r.db.WithContext(ctx).Create(&admissions).Error
+}
@@ -2,45 +2,96 @@ package booking
 
 import (
 	"context"
+	"crypto/ed25519"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+
 	"github.com/aparnasukesh/inter-communication/payment"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/rbac"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/eticket"
+	grpclient "github.com/aparnasukesh/movies-booking-svc/pkg/grpClient"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/paycallback"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/utils"
+	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 )
 
+var tracer = otel.Tracer("github.com/aparnasukesh/movies-booking-svc/internal/app/booking")
+
 type service struct {
 	db            *gorm.DB
 	repo          Repository
 	movieRepo     movies.Repository
 	theaterRepo   theatres.Repository
+	rbac          rbac.Service
 	paymentClient payment.PaymentServiceClient
+	callbacks     *paycallback.Verifier
+	users         grpclient.UserDirectory
+	ticketSigner  *eticket.Signer
+	checkInWindow CheckInWindow
+	agePolicy     AgeRatingPolicy
+	metrics       *metrics.Metrics
 }
 
 type Service interface {
 	CreateBooking(ctx context.Context, createReq CreateBookingRequest) (*Booking, []BookingSeat, error)
 	GetBookingByID(ctx context.Context, bookingId int) (*Booking, error)
-	ListBookingsByUser(ctx context.Context, userId int) ([]Booking, error)
+	ListBookingsByUser(ctx context.Context, userId int, page pagination.Request) ([]Booking, string, error)
 	DeleteBookingByBookingID(ctx context.Context, bookingId int) error
 	UpdateBookingStatusByBookingID(ctx context.Context, bookingId int, status string) error
+	// Payments
+	StartPayment(ctx context.Context, bookingId int, paymentMethodId int) (*Booking, error)
+	ReconcilePayments(ctx context.Context, staleAfter time.Duration) (ReconcileSummary, error)
+	HandlePaymentResult(ctx context.Context, result PaymentResult) (*Booking, bool, error)
+	PaymentReport(ctx context.Context, from, to time.Time) (*PaymentReport, error)
+	// Tickets
+	GetTicket(ctx context.Context, bookingId int) (*TicketResponse, error)
+	TicketPublicKey() ed25519.PublicKey
+	CheckIn(ctx context.Context, req CheckInRequest) (*CheckInResult, error)
 }
 
-func NewService(db *gorm.DB, repo Repository, movieRepo movies.Repository, theaterRepo theatres.Repository, paymentClient payment.PaymentServiceClient) Service {
+func NewService(db *gorm.DB, repo Repository, movieRepo movies.Repository, theaterRepo theatres.Repository, rbacSvc rbac.Service, paymentClient payment.PaymentServiceClient, callbacks *paycallback.Verifier, users grpclient.UserDirectory, ticketSigner *eticket.Signer, checkInWindow CheckInWindow, agePolicy AgeRatingPolicy, metrics *metrics.Metrics) Service {
+	if agePolicy == "" {
+		agePolicy = AgeRatingBlock
+	}
 	return &service{
 		db:            db,
 		repo:          repo,
 		movieRepo:     movieRepo,
 		theaterRepo:   theaterRepo,
+		rbac:          rbacSvc,
 		paymentClient: paymentClient,
+		callbacks:     callbacks,
+		users:         users,
+		ticketSigner:  ticketSigner,
+		checkInWindow: checkInWindow,
+		agePolicy:     agePolicy,
+		metrics:       metrics,
 	}
 }
 
 func (s *service) CreateBooking(ctx context.Context, createReq CreateBookingRequest) (*Booking, []BookingSeat, error) {
+	if _, err := auth.RequireUser(ctx, uint(createReq.UserID)); err != nil {
+		return nil, nil, fmt.Errorf("unauthorized: bookings can only be created for the calling user: %w", err)
+	}
+	user, err := s.activeUser(ctx, createReq.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
 	showtime, err := s.theaterRepo.GetShowtimeByID(ctx, createReq.ShowtimeID)
 	if err != nil && err == gorm.ErrRecordNotFound {
-		return nil, nil, fmt.Errorf("invalid showtime id %d", createReq.ShowtimeID)
+		return nil, nil, apperrors.InvalidArgument("invalid showtime id %d", createReq.ShowtimeID)
 	}
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, nil, err
@@ -51,10 +102,18 @@ func (s *service) CreateBooking(ctx context.Context, createReq CreateBookingRequ
 	}
 
 	if len(seats) == 0 {
-		return nil, nil, fmt.Errorf("no valid seats found for the provided seat IDs")
+		return nil, nil, apperrors.InvalidArgument("no valid seats found for the provided seat IDs")
+	}
+	ageResult, err := s.checkAge(ctx, user, showtime)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	tx := s.db.Begin()
+	// The span covers the seat check and inserts so slow locks show up
+	// separately from the lookups above.
+	ctx, span := tracer.Start(ctx, "booking.CreateBooking.transaction")
+	defer span.End()
+	tx := s.db.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
@@ -76,6 +135,10 @@ func (s *service) CreateBooking(ctx context.Context, createReq CreateBookingRequ
 		BookingDate:   time.Now(),
 		TotalAmount:   totalAmount,
 		PaymentStatus: "Pending",
+
+		AgeCheck:            ageResult.result,
+		AgeCheckCertificate: ageResult.certificate,
+		AgeAtShow:           ageResult.age,
 	}
 	if err := tx.Create(&booking).Error; err != nil {
 		tx.Rollback()
@@ -97,22 +160,28 @@ func (s *service) CreateBooking(ctx context.Context, createReq CreateBookingRequ
 	if err := tx.Commit().Error; err != nil {
 		return nil, nil, err
 	}
+	s.metrics.BookingEvent(metrics.BookingCreated)
+	logger.FromContext(ctx).Info("booking created", "booking_id", booking.BookingID, "showtime_id", booking.ShowtimeID, "seats", len(bookingSeats), "age_check", booking.AgeCheck)
 
 	return booking, bookingSeats, nil
 }
 
+// checkSeatAvailability fails when any of the seats is held by a booking of
+// the show. Cancelled bookings and bookings whose payment failed free their
+// seats.
 func (s *service) checkSeatAvailability(ctx context.Context, tx *gorm.DB, showtimeID int, seatIDs []int) error {
 	var existingBookings []BookingSeat
-	err := tx.Table("booking_seats").
-		Joins("JOIN bookings ON bookings.booking_id = booking_seats.booking_id").
+	err := tx.Model(&BookingSeat{}).
+		Joins("JOIN bookings ON bookings.booking_id = booking_seats.booking_id AND bookings.deleted_at IS NULL").
 		Where("bookings.showtime_id = ? AND booking_seats.seat_id IN ?", showtimeID, seatIDs).
+		Where("bookings.payment_status NOT ILIKE ?", PaymentStatusFailed).
 		Find(&existingBookings).Error
 
 	if err != nil {
 		return err
 	}
 	if len(existingBookings) > 0 {
-		return fmt.Errorf("one or more of the requested seats are already booked")
+		return apperrors.Conflict("one or more of the requested seats are already booked")
 	}
 
 	return nil
@@ -123,33 +192,316 @@ func (s *service) GetBookingByID(ctx context.Context, bookingId int) (*Booking,
 	if err != nil {
 		return nil, err
 	}
+	if err := s.authorizeBookingAccess(ctx, bookings); err != nil {
+		return nil, err
+	}
 	return bookings, err
 }
 
-func (s *service) ListBookingsByUser(ctx context.Context, userId int) ([]Booking, error) {
-	bookings, err := s.repo.ListBookingsByUser(ctx, userId)
-	if len(bookings) < 1 {
-		return nil, fmt.Errorf("no bookings found with user id %d", userId)
+// authorizeBookingAccess allows the user who made the booking, the owner and
+// staff of the theater it was made in and super admins.
+func (s *service) authorizeBookingAccess(ctx context.Context, booking *Booking) error {
+	if _, err := auth.RequireUser(ctx, booking.UserID); err == nil {
+		return nil
+	} else if err == auth.ErrUnauthenticated {
+		return err
 	}
+	screen, err := s.theaterRepo.GetTheaterScreenByID(ctx, int(booking.ScreenID))
 	if err != nil {
-		return nil, err
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("unauthorized: booking %d does not belong to the caller: %w", booking.BookingID, auth.ErrPermissionDenied)
+		}
+		return err
+	}
+	if _, err := s.rbac.AuthorizeTheater(ctx, uint(screen.TheaterID), rbac.PermViewBookings); err != nil {
+		return fmt.Errorf("unauthorized: booking %d does not belong to the caller: %w", booking.BookingID, err)
 	}
-	return bookings, nil
+	return nil
+}
+
+func (s *service) ListBookingsByUser(ctx context.Context, userId int, page pagination.Request) ([]Booking, string, error) {
+	if _, err := auth.RequireUser(ctx, uint(userId)); err != nil {
+		return nil, "", fmt.Errorf("unauthorized: bookings can only be listed by their owner: %w", err)
+	}
+	bookings, err := s.repo.ListBookingsByUser(ctx, userId, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(bookings.Items) < 1 {
+		return nil, "", apperrors.NotFound("no bookings found with user id %d", userId)
+	}
+	return bookings.Items, bookings.NextPageToken, nil
 }
 
 func (s *service) DeleteBookingByBookingID(ctx context.Context, bookingId int) error {
+	booking, err := s.repo.GetBookingByID(ctx, bookingId)
+	if err != nil {
+		return err
+	}
+	if _, err := auth.RequireUser(ctx, booking.UserID); err != nil {
+		return fmt.Errorf("unauthorized: only the booking owner can delete booking %d: %w", bookingId, err)
+	}
 	if err := s.repo.DeleteBookingByBookingID(ctx, bookingId); err != nil {
 		return err
 	}
 	if err := s.repo.DeleteBookingSeats(ctx, bookingId); err != nil {
 		return err
 	}
+	s.metrics.BookingEvent(metrics.BookingCancelled)
 	return nil
 }
 
 func (s *service) UpdateBookingStatusByBookingID(ctx context.Context, bookingId int, status string) error {
-	if err := s.repo.UpdateBookingStatusByBookingID(ctx, bookingId, status); err != nil {
+	if _, err := auth.RequireRole(ctx, auth.RoleService); err != nil {
+		return fmt.Errorf("unauthorized: booking status can only be updated by the payment service: %w", err)
+	}
+	settled := settledStatus(status)
+	if settled == "" {
+		return apperrors.InvalidArgument("invalid payment status %q, expected %s or %s", status, PaymentStatusSuccess, PaymentStatusFailed)
+	}
+	booking, err := s.repo.GetBookingByID(ctx, bookingId)
+	if err != nil {
 		return err
 	}
-	return nil
+	_, err = s.settlePayment(ctx, booking, settled)
+	return err
+}
+
+// settlePayment records the outcome of a booking's payment. It reports false
+// when the booking was already settled, e.g. because a payment callback was
+// delivered twice.
+func (s *service) settlePayment(ctx context.Context, booking *Booking, status string) (bool, error) {
+	applied, err := s.repo.UpdateBookingStatusByBookingID(ctx, int(booking.BookingID), status)
+	if err != nil {
+		return false, err
+	}
+	if applied {
+		s.countSettlement(booking, status)
+	}
+	return applied, nil
+}
+
+func (s *service) countSettlement(booking *Booking, status string) {
+	switch status {
+	case PaymentStatusSuccess:
+		s.metrics.BookingEvent(metrics.BookingPaid)
+		s.metrics.SeatsSold(booking.ShowtimeID, len(booking.BookingSeats))
+	case PaymentStatusFailed:
+		s.metrics.BookingEvent(metrics.BookingFailed)
+	}
+}
+
+// Tickets
+func (s *service) TicketPublicKey() ed25519.PublicKey {
+	return s.ticketSigner.PublicKey()
+}
+
+func (s *service) GetTicket(ctx context.Context, bookingId int) (*TicketResponse, error) {
+	booking, err := s.repo.GetBookingByID(ctx, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeBookingAccess(ctx, booking); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(booking.PaymentStatus, PaymentStatusSuccess) {
+		return nil, apperrors.FailedPrecondition("ticket is not available for booking %d with payment status %s", bookingId, booking.PaymentStatus)
+	}
+	ticket, err := s.repo.GetTicketByBookingID(ctx, bookingId)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if err == gorm.ErrRecordNotFound {
+		ticket, err = s.issueTicket(ctx, booking)
+		if err != nil {
+			return nil, err
+		}
+	}
+	png, err := eticket.QRCodePNG(ticket.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &TicketResponse{
+		Ticket:      *ticket,
+		SeatNumbers: strings.Split(ticket.SeatNumbers, ","),
+		QRCodePNG:   png,
+	}, nil
+}
+
+func (s *service) issueTicket(ctx context.Context, booking *Booking) (*Ticket, error) {
+	showtime, err := s.theaterRepo.GetShowtimeByID(ctx, int(booking.ShowtimeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch showtime %d: %w", booking.ShowtimeID, err)
+	}
+	seatIds := make([]int, len(booking.BookingSeats))
+	for i, seat := range booking.BookingSeats {
+		seatIds[i] = int(seat.SeatID)
+	}
+	seats, err := s.theaterRepo.GetSeatsByIds(ctx, seatIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) == 0 {
+		return nil, apperrors.NotFound("no seats found for booking %d", booking.BookingID)
+	}
+	seatNumbers := make([]string, len(seats))
+	for i, seat := range seats {
+		seatNumbers[i] = seat.SeatNumber
+	}
+	sort.Strings(seatNumbers)
+
+	// The ticket is valid for as long as check-in accepts it.
+	validFrom, validUntil := s.checkInWindow.Around(utils.CombineDateAndTime(showtime.ShowDate, showtime.ShowTime))
+
+	payload, err := s.ticketSigner.Sign(eticket.Payload{
+		BookingID:   booking.BookingID,
+		ShowtimeID:  booking.ShowtimeID,
+		SeatNumbers: seatNumbers,
+		ValidFrom:   validFrom.Unix(),
+		ValidUntil:  validUntil.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ticket := &Ticket{
+		BookingID:   booking.BookingID,
+		ShowtimeID:  booking.ShowtimeID,
+		SeatNumbers: strings.Join(seatNumbers, ","),
+		Payload:     payload,
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
+		IssuedAt:    time.Now(),
+	}
+	if err := s.repo.CreateTicket(ctx, ticket); err != nil {
+		// A concurrent request may have issued the ticket first.
+		existing, getErr := s.repo.GetTicketByBookingID(ctx, int(booking.BookingID))
+		if getErr == nil {
+			return existing, nil
+		}
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// Check-in
+func (s *service) CheckIn(ctx context.Context, req CheckInRequest) (*CheckInResult, error) {
+	if req.DeviceID == "" {
+		return nil, apperrors.InvalidArgument("device id is required for check-in")
+	}
+	theater, err := s.theaterRepo.GetTheaterByID(ctx, req.TheaterID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("theater with ID %d does not exist", req.TheaterID)
+		}
+		return nil, err
+	}
+	if _, err := s.rbac.AuthorizeTheater(ctx, theater.ID, rbac.PermCheckInTickets); err != nil {
+		return nil, fmt.Errorf("unauthorized: cannot check in tickets at theater %d: %w", theater.ID, err)
+	}
+	now := time.Now()
+	payload, err := eticket.Verify(s.ticketSigner.PublicKey(), req.Payload, now)
+	if err != nil {
+		if errors.Is(err, eticket.ErrNotYetValid) || errors.Is(err, eticket.ErrExpired) {
+			return rejectCheckIn(CheckInRejectionOutsideWindow, 0, err.Error()), nil
+		}
+		return rejectCheckIn(CheckInRejectionInvalidTicket, 0, err.Error()), nil
+	}
+	booking, err := s.repo.GetBookingByID(ctx, int(payload.BookingID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return rejectCheckIn(CheckInRejectionBookingNotFound, payload.BookingID, fmt.Sprintf("booking %d does not exist", payload.BookingID)), nil
+		}
+		return nil, err
+	}
+	if booking.ShowtimeID != payload.ShowtimeID {
+		return rejectCheckIn(CheckInRejectionInvalidTicket, booking.BookingID, "ticket does not match the booking showtime"), nil
+	}
+	if !strings.EqualFold(booking.PaymentStatus, PaymentStatusSuccess) {
+		return rejectCheckIn(CheckInRejectionBookingNotPaid, booking.BookingID, fmt.Sprintf("booking payment status is %s", booking.PaymentStatus)), nil
+	}
+	showtime, err := s.theaterRepo.GetShowtimeByID(ctx, int(booking.ShowtimeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch showtime %d: %w", booking.ShowtimeID, err)
+	}
+	if showtime.TheaterScreen.TheaterID != req.TheaterID {
+		return rejectCheckIn(CheckInRejectionWrongTheater, booking.BookingID, fmt.Sprintf("ticket is for theater %d", showtime.TheaterScreen.TheaterID)), nil
+	}
+	opens, closes := s.checkInWindow.Around(utils.CombineDateAndTime(showtime.ShowDate, showtime.ShowTime))
+	if now.Before(opens) || now.After(closes) {
+		return rejectCheckIn(CheckInRejectionOutsideWindow, booking.BookingID, fmt.Sprintf("check-in is open from %s to %s",
+			opens.Format(time.RFC3339), closes.Format(time.RFC3339))), nil
+	}
+	if result, err := s.checkAlreadyAdmitted(ctx, booking.BookingID); result != nil || err != nil {
+		return result, err
+	}
+
+	seatIds := make([]int, len(booking.BookingSeats))
+	for i, seat := range booking.BookingSeats {
+		seatIds[i] = int(seat.SeatID)
+	}
+	seats, err := s.theaterRepo.GetSeatsByIds(ctx, seatIds)
+	if err != nil {
+		return nil, err
+	}
+	admissions := make([]Admission, len(seats))
+	seatNumbers := make([]string, len(seats))
+	for i, seat := range seats {
+		admissions[i] = Admission{
+			BookingID:  booking.BookingID,
+			SeatID:     seat.ID,
+			SeatNumber: seat.SeatNumber,
+			ShowtimeID: booking.ShowtimeID,
+			TheaterID:  uint(req.TheaterID),
+			DeviceID:   req.DeviceID,
+			AdmittedAt: now,
+		}
+		seatNumbers[i] = seat.SeatNumber
+	}
+	sort.Strings(seatNumbers)
+
+	if err := s.repo.CreateAdmissions(ctx, admissions); err != nil {
+		// The unique booking/seat index rejects a second scan racing this one.
+		if result, checkErr := s.checkAlreadyAdmitted(ctx, booking.BookingID); result != nil {
+			return result, nil
+		} else if checkErr != nil {
+			return nil, checkErr
+		}
+		return nil, err
+	}
+	logger.FromContext(ctx).Info("tickets checked in", "booking_id", booking.BookingID, "theater_id", req.TheaterID, "device_id", req.DeviceID, "seats", len(seatNumbers))
+
+	return &CheckInResult{
+		Admitted:    true,
+		Message:     fmt.Sprintf("admitted %d seat(s)", len(seatNumbers)),
+		BookingID:   booking.BookingID,
+		SeatNumbers: seatNumbers,
+		CheckedInAt: now,
+	}, nil
+}
+
+func (s *service) checkAlreadyAdmitted(ctx context.Context, bookingId uint) (*CheckInResult, error) {
+	admissions, err := s.repo.GetAdmissionsByBookingID(ctx, int(bookingId))
+	if err != nil {
+		return nil, err
+	}
+	if len(admissions) == 0 {
+		return nil, nil
+	}
+	first := admissions[0]
+	result := rejectCheckIn(CheckInRejectionAlreadyCheckedIn, bookingId, fmt.Sprintf("ticket was already used at %s by device %s", first.AdmittedAt.Format(time.RFC3339), first.DeviceID))
+	result.CheckedInAt = first.AdmittedAt
+	for _, admission := range admissions {
+		result.SeatNumbers = append(result.SeatNumbers, admission.SeatNumber)
+	}
+	sort.Strings(result.SeatNumbers)
+	return result, nil
+}
+
+func rejectCheckIn(reason CheckInRejection, bookingId uint, message string) *CheckInResult {
+	return &CheckInResult{
+		Admitted:        false,
+		RejectionReason: reason,
+		Message:         message,
+		BookingID:       bookingId,
+	}
 }
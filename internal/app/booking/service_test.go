@@ -0,0 +1,435 @@
+package booking
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/rbac"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/eticket"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds SQL without a database and hands each query to capture.
+func dryRunDB(t *testing.T, capture func(sql string)) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	err = db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		capture(tx.Statement.SQL.String())
+	})
+	if err != nil {
+		t.Fatalf("register capture callback: %v", err)
+	}
+	return db
+}
+
+func TestCheckSeatAvailabilityIgnoresReleasedBookings(t *testing.T) {
+	var query string
+	svc := &service{}
+	db := dryRunDB(t, func(sql string) { query = sql })
+	if err := svc.checkSeatAvailability(context.Background(), db, 1, []int{1, 2}); err != nil {
+		t.Fatalf("checkSeatAvailability: %v", err)
+	}
+
+	for _, want := range []string{
+		"bookings.deleted_at IS NULL",
+		`"booking_seats"."deleted_at" IS NULL`,
+		"bookings.payment_status NOT ILIKE",
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("query does not contain %q:\n%s", want, query)
+		}
+	}
+}
+
+// fakeRepo keeps bookings and admissions in memory. Methods the tests don't
+// set up panic through the nil embedded Repository.
+type fakeRepo struct {
+	Repository
+
+	mu         sync.Mutex
+	bookings   map[uint]Booking
+	admissions []Admission
+	// admissionChecks, if set, holds the first calls of
+	// GetAdmissionsByBookingID until that many have arrived, so concurrent
+	// check-ins all get past the first check before any of them inserts.
+	admissionChecks *sync.WaitGroup
+	checks          int
+	settlements     int
+}
+
+func (r *fakeRepo) GetBookingByID(ctx context.Context, bookingId int) (*Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	booking, ok := r.bookings[uint(bookingId)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &booking, nil
+}
+
+func (r *fakeRepo) GetAdmissionsByBookingID(ctx context.Context, bookingId int) ([]Admission, error) {
+	r.mu.Lock()
+	r.checks++
+	wait := r.admissionChecks != nil && r.checks <= 2
+	r.mu.Unlock()
+	if wait {
+		r.admissionChecks.Done()
+		r.admissionChecks.Wait()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var admissions []Admission
+	for _, admission := range r.admissions {
+		if admission.BookingID == uint(bookingId) {
+			admissions = append(admissions, admission)
+		}
+	}
+	return admissions, nil
+}
+
+// CreateAdmissions enforces the unique booking/seat index.
+func (r *fakeRepo) CreateAdmissions(ctx context.Context, admissions []Admission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, admission := range admissions {
+		for _, existing := range r.admissions {
+			if existing.BookingID == admission.BookingID && existing.SeatID == admission.SeatID {
+				return errors.New(`duplicate key value violates unique constraint "idx_admission_booking_seat"`)
+			}
+		}
+	}
+	r.admissions = append(r.admissions, admissions...)
+	return nil
+}
+
+type fakeTheaterRepo struct {
+	theatres.Repository
+
+	theaters  map[int]theatres.Theater
+	showtimes map[int]theatres.Showtime
+	seats     map[int]theatres.Seat
+}
+
+func (r *fakeTheaterRepo) GetTheaterByID(ctx context.Context, id int) (*theatres.Theater, error) {
+	theater, ok := r.theaters[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &theater, nil
+}
+
+func (r *fakeTheaterRepo) GetShowtimeByID(ctx context.Context, id int) (*theatres.Showtime, error) {
+	showtime, ok := r.showtimes[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &showtime, nil
+}
+
+func (r *fakeTheaterRepo) GetSeatsByIds(ctx context.Context, ids []int) ([]theatres.Seat, error) {
+	seats := make([]theatres.Seat, 0, len(ids))
+	for _, id := range ids {
+		seats = append(seats, r.seats[id])
+	}
+	return seats, nil
+}
+
+// fakeRBAC lets staff check in at the theaters in allowed.
+type fakeRBAC struct {
+	rbac.Service
+
+	allowed map[uint]bool
+}
+
+func (f fakeRBAC) AuthorizeTheater(ctx context.Context, theaterId uint, permission rbac.Permission) (auth.Identity, error) {
+	if !f.allowed[theaterId] {
+		return auth.Identity{}, apperrors.PermissionDenied("not staff of theater %d", theaterId)
+	}
+	return auth.Identity{UserID: ownerAdminID, Role: auth.RoleAdmin}, nil
+}
+
+const (
+	checkInTheaterID = 1
+	otherTheaterID   = 2
+	ownerAdminID     = 7
+	showtimeID       = 5
+	paidBookingID    = 10
+	unpaidBookingID  = 11
+)
+
+func testSigner(t *testing.T) *eticket.Signer {
+	t.Helper()
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := eticket.NewSigner(base64.StdEncoding.EncodeToString(seed))
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	return signer
+}
+
+// newCheckInService returns a service with a paid and an unpaid booking of
+// seats A1 and A2 for a show at checkInTheaterID starting at showStart.
+func newCheckInService(t *testing.T, showStart time.Time) (*service, *fakeRepo) {
+	t.Helper()
+	seats := []BookingSeat{{SeatID: 1}, {SeatID: 2}}
+	repo := &fakeRepo{bookings: map[uint]Booking{
+		paidBookingID:   {BookingID: paidBookingID, ShowtimeID: showtimeID, PaymentStatus: PaymentStatusSuccess, BookingSeats: seats},
+		unpaidBookingID: {BookingID: unpaidBookingID, ShowtimeID: showtimeID, PaymentStatus: PaymentStatusProcessing, BookingSeats: seats},
+	}}
+	theaterRepo := &fakeTheaterRepo{
+		theaters: map[int]theatres.Theater{
+			checkInTheaterID: {Model: gorm.Model{ID: checkInTheaterID}},
+			otherTheaterID:   {Model: gorm.Model{ID: otherTheaterID}},
+		},
+		showtimes: map[int]theatres.Showtime{
+			showtimeID: {
+				Model:         gorm.Model{ID: showtimeID},
+				ShowDate:      showStart,
+				ShowTime:      showStart,
+				TheaterScreen: theatres.TheaterScreen{TheaterID: checkInTheaterID},
+			},
+		},
+		seats: map[int]theatres.Seat{
+			1: {Model: gorm.Model{ID: 1}, SeatNumber: "A1"},
+			2: {Model: gorm.Model{ID: 2}, SeatNumber: "A2"},
+		},
+	}
+	svc := &service{
+		repo:          repo,
+		theaterRepo:   theaterRepo,
+		rbac:          fakeRBAC{allowed: map[uint]bool{checkInTheaterID: true, otherTheaterID: true}},
+		ticketSigner:  testSigner(t),
+		checkInWindow: CheckInWindow{OpensBefore: 45 * time.Minute, ClosesAfter: 30 * time.Minute},
+	}
+	return svc, repo
+}
+
+// ticket signs a ticket for bookingId that is valid for the next hour.
+func ticket(t *testing.T, signer *eticket.Signer, bookingId uint) string {
+	t.Helper()
+	now := time.Now()
+	token, err := signer.Sign(eticket.Payload{
+		BookingID:   bookingId,
+		ShowtimeID:  showtimeID,
+		SeatNumbers: []string{"A1", "A2"},
+		ValidFrom:   now.Add(-time.Hour).Unix(),
+		ValidUntil:  now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return token
+}
+
+func TestCheckIn(t *testing.T) {
+	svc, repo := newCheckInService(t, time.Now().Add(10*time.Minute))
+	result, err := svc.CheckIn(context.Background(), CheckInRequest{
+		Payload:   ticket(t, svc.ticketSigner, paidBookingID),
+		TheaterID: checkInTheaterID,
+		DeviceID:  "gate-1",
+	})
+	if err != nil {
+		t.Fatalf("CheckIn: %v", err)
+	}
+	if !result.Admitted || result.BookingID != paidBookingID || strings.Join(result.SeatNumbers, ",") != "A1,A2" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if len(repo.admissions) != 2 || repo.admissions[0].DeviceID != "gate-1" || repo.admissions[0].TheaterID != checkInTheaterID {
+		t.Fatalf("unexpected admissions %+v", repo.admissions)
+	}
+}
+
+func TestCheckInRejections(t *testing.T) {
+	tests := []struct {
+		name      string
+		showStart time.Time
+		theaterID int
+		payload   func(t *testing.T, svc *service) string
+		setup     func(repo *fakeRepo)
+		want      CheckInRejection
+	}{
+		{
+			name: "bad signature",
+			payload: func(t *testing.T, svc *service) string {
+				return ticket(t, testSigner(t), paidBookingID)
+			},
+			want: CheckInRejectionInvalidTicket,
+		},
+		{
+			name: "malformed ticket",
+			payload: func(t *testing.T, svc *service) string {
+				return "not a ticket"
+			},
+			want: CheckInRejectionInvalidTicket,
+		},
+		{
+			name: "unknown booking",
+			payload: func(t *testing.T, svc *service) string {
+				return ticket(t, svc.ticketSigner, 99)
+			},
+			want: CheckInRejectionBookingNotFound,
+		},
+		{
+			name: "unpaid booking",
+			payload: func(t *testing.T, svc *service) string {
+				return ticket(t, svc.ticketSigner, unpaidBookingID)
+			},
+			want: CheckInRejectionBookingNotPaid,
+		},
+		{
+			name:      "wrong theater",
+			theaterID: otherTheaterID,
+			want:      CheckInRejectionWrongTheater,
+		},
+		{
+			name:      "before check-in opens",
+			showStart: time.Now().Add(time.Hour),
+			want:      CheckInRejectionOutsideWindow,
+		},
+		{
+			name:      "after check-in closes",
+			showStart: time.Now().Add(-time.Hour),
+			want:      CheckInRejectionOutsideWindow,
+		},
+		{
+			name: "expired ticket",
+			payload: func(t *testing.T, svc *service) string {
+				token, err := svc.ticketSigner.Sign(eticket.Payload{
+					BookingID:  paidBookingID,
+					ShowtimeID: showtimeID,
+					ValidFrom:  time.Now().Add(-2 * time.Hour).Unix(),
+					ValidUntil: time.Now().Add(-time.Hour).Unix(),
+				})
+				if err != nil {
+					t.Fatalf("Sign: %v", err)
+				}
+				return token
+			},
+			want: CheckInRejectionOutsideWindow,
+		},
+		{
+			name: "already admitted",
+			setup: func(repo *fakeRepo) {
+				repo.admissions = []Admission{{BookingID: paidBookingID, SeatID: 1, SeatNumber: "A1", DeviceID: "gate-2", AdmittedAt: time.Now()}}
+			},
+			want: CheckInRejectionAlreadyCheckedIn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			showStart := tt.showStart
+			if showStart.IsZero() {
+				showStart = time.Now().Add(10 * time.Minute)
+			}
+			svc, repo := newCheckInService(t, showStart)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			payload := ticket(t, svc.ticketSigner, paidBookingID)
+			if tt.payload != nil {
+				payload = tt.payload(t, svc)
+			}
+			theaterID := tt.theaterID
+			if theaterID == 0 {
+				theaterID = checkInTheaterID
+			}
+			before := len(repo.admissions)
+
+			result, err := svc.CheckIn(context.Background(), CheckInRequest{Payload: payload, TheaterID: theaterID, DeviceID: "gate-1"})
+			if err != nil {
+				t.Fatalf("CheckIn: %v", err)
+			}
+			if result.Admitted || result.RejectionReason != tt.want {
+				t.Fatalf("result = %+v, want rejection %s", result, tt.want)
+			}
+			if len(repo.admissions) != before {
+				t.Fatalf("rejected check-in admitted %d seats", len(repo.admissions)-before)
+			}
+		})
+	}
+}
+
+func TestCheckInErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  CheckInRequest
+		want apperrors.Kind
+	}{
+		{"no device", CheckInRequest{TheaterID: checkInTheaterID}, apperrors.KindInvalidArgument},
+		{"unknown theater", CheckInRequest{TheaterID: 3, DeviceID: "gate-1"}, apperrors.KindNotFound},
+		{"not staff", CheckInRequest{TheaterID: otherTheaterID, DeviceID: "gate-1"}, apperrors.KindPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newCheckInService(t, time.Now().Add(10*time.Minute))
+			svc.rbac = fakeRBAC{allowed: map[uint]bool{checkInTheaterID: true}}
+			tt.req.Payload = ticket(t, svc.ticketSigner, paidBookingID)
+
+			_, err := svc.CheckIn(context.Background(), tt.req)
+			var appErr *apperrors.Error
+			if !errors.As(err, &appErr) || appErr.Kind != tt.want {
+				t.Fatalf("CheckIn error = %v, want kind %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckInConcurrentScansAdmitOnce(t *testing.T) {
+	svc, repo := newCheckInService(t, time.Now().Add(10*time.Minute))
+	repo.admissionChecks = &sync.WaitGroup{}
+	repo.admissionChecks.Add(2)
+	payload := ticket(t, svc.ticketSigner, paidBookingID)
+
+	results := make([]*CheckInResult, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = svc.CheckIn(context.Background(), CheckInRequest{
+				Payload:   payload,
+				TheaterID: checkInTheaterID,
+				DeviceID:  fmt.Sprintf("gate-%d", i+1),
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	admitted := 0
+	for i, result := range results {
+		if errs[i] != nil {
+			t.Fatalf("CheckIn %d: %v", i, errs[i])
+		}
+		if result.Admitted {
+			admitted++
+		} else if result.RejectionReason != CheckInRejectionAlreadyCheckedIn {
+			t.Fatalf("CheckIn %d rejected with %s, want %s", i, result.RejectionReason, CheckInRejectionAlreadyCheckedIn)
+		}
+	}
+	if admitted != 1 || len(repo.admissions) != 2 {
+		t.Fatalf("admitted %d times with %d admissions, want once with 2", admitted, len(repo.admissions))
+	}
+}
@@ -0,0 +1,71 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/ticketing"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TicketGrpcHandler struct {
+	svc Service
+	ticketing.UnimplementedTicketServiceServer
+}
+
+func NewTicketGrpcHandler(svc Service) TicketGrpcHandler {
+	return TicketGrpcHandler{
+		svc: svc,
+	}
+}
+
+func (h *TicketGrpcHandler) GetTicket(ctx context.Context, req *ticketing.GetTicketRequest) (*ticketing.GetTicketResponse, error) {
+	ticket, err := h.svc.GetTicket(ctx, int(req.BookingId))
+	if err != nil {
+		return nil, err
+	}
+	return &ticketing.GetTicketResponse{
+		Ticket: &ticketing.Ticket{
+			BookingId:   uint32(ticket.Ticket.BookingID),
+			ShowtimeId:  uint32(ticket.Ticket.ShowtimeID),
+			SeatNumbers: ticket.SeatNumbers,
+			ValidFrom:   timestamppb.New(ticket.Ticket.ValidFrom),
+			ValidUntil:  timestamppb.New(ticket.Ticket.ValidUntil),
+			IssuedAt:    timestamppb.New(ticket.Ticket.IssuedAt),
+			Payload:     ticket.Ticket.Payload,
+			QrCodePng:   ticket.QRCodePNG,
+		},
+	}, nil
+}
+
+func (h *TicketGrpcHandler) GetTicketPublicKey(ctx context.Context, req *ticketing.GetTicketPublicKeyRequest) (*ticketing.GetTicketPublicKeyResponse, error) {
+	return &ticketing.GetTicketPublicKeyResponse{
+		Algorithm: "Ed25519",
+		PublicKey: h.svc.TicketPublicKey(),
+	}, nil
+}
+
+func (h *TicketGrpcHandler) CheckIn(ctx context.Context, req *ticketing.CheckInRequest) (*ticketing.CheckInResponse, error) {
+	result, err := h.svc.CheckIn(ctx, CheckInRequest{
+		Payload:   req.Payload,
+		TheaterID: int(req.TheaterId),
+		DeviceID:  req.DeviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Admitted {
+		logger.FromContext(ctx).Info("check-in rejected", "booking_id", result.BookingID, "theater_id", req.TheaterId, "device_id", req.DeviceId, "reason", string(result.RejectionReason))
+	}
+	response := &ticketing.CheckInResponse{
+		Admitted:        result.Admitted,
+		RejectionReason: ticketing.CheckInRejectionReason(ticketing.CheckInRejectionReason_value[string(result.RejectionReason)]),
+		Message:         result.Message,
+		BookingId:       uint32(result.BookingID),
+		SeatNumbers:     result.SeatNumbers,
+	}
+	if !result.CheckedInAt.IsZero() {
+		response.CheckedInAt = timestamppb.New(result.CheckedInAt)
+	}
+	return response, nil
+}
@@ -0,0 +1,27 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aparnasukesh/inter-communication/user_admin"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// activeUser fetches the booking user from the user-admin service and
+// refuses ids it doesn't know and users who haven't been verified.
+func (s *service) activeUser(ctx context.Context, userID int) (*user_admin.User, error) {
+	user, err := s.users.GetUser(ctx, userID)
+	if status.Code(err) == codes.NotFound {
+		return nil, apperrors.FailedPrecondition("user %d does not exist", userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %d: %w", userID, err)
+	}
+	if !user.GetIsVerified() {
+		return nil, apperrors.FailedPrecondition("user %d is not verified", userID)
+	}
+	return user, nil
+}
@@ -0,0 +1,56 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aparnasukesh/inter-communication/user_admin"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	grpclient "github.com/aparnasukesh/movies-booking-svc/pkg/grpClient"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeUsers struct {
+	grpclient.UserDirectory
+	users map[int]*user_admin.User
+	err   error
+}
+
+func (f fakeUsers) GetUser(ctx context.Context, userID int) (*user_admin.User, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	user, ok := f.users[userID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", userID)
+	}
+	return user, nil
+}
+
+func TestActiveUser(t *testing.T) {
+	users := fakeUsers{users: map[int]*user_admin.User{
+		1: {Id: 1, IsVerified: true},
+		2: {Id: 2, IsVerified: false},
+	}}
+	svc := &service{users: users}
+
+	user, err := svc.activeUser(context.Background(), 1)
+	if err != nil || user.GetId() != 1 {
+		t.Fatalf("activeUser(1) = %v, %v, want user 1", user, err)
+	}
+	for _, id := range []int{2, 3} {
+		if _, err := svc.activeUser(context.Background(), id); !apperrors.Is(err, apperrors.KindFailedPrecondition) {
+			t.Errorf("activeUser(%d) = %v, want failed precondition", id, err)
+		}
+	}
+
+	// An unreachable user-admin service is not the user's fault.
+	down := status.Error(codes.Unavailable, "connection refused")
+	svc = &service{users: fakeUsers{err: down}}
+	_, err = svc.activeUser(context.Background(), 1)
+	if !errors.Is(err, down) || apperrors.Is(err, apperrors.KindFailedPrecondition) {
+		t.Fatalf("activeUser with user-admin down = %v, want %v", err, down)
+	}
+}
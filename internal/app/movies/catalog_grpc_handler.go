@@ -0,0 +1,408 @@
+package movies
+
+import (
+	"context"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CatalogGrpcHandler struct {
+	svc Service
+	catalog.UnimplementedCatalogServiceServer
+}
+
+func NewCatalogGrpcHandler(svc Service) CatalogGrpcHandler {
+	return CatalogGrpcHandler{
+		svc: svc,
+	}
+}
+
+func (h *CatalogGrpcHandler) SearchMovies(ctx context.Context, req *catalog.SearchMoviesRequest) (*catalog.SearchMoviesResponse, error) {
+	query := SearchQuery{
+		Text:      req.Query,
+		Languages: req.Languages,
+		Genres:    req.Genres,
+		MinRating: req.MinRating,
+		MaxRating: req.MaxRating,
+	}
+	if req.ReleasedAfter != nil {
+		after := req.ReleasedAfter.AsTime()
+		query.ReleasedAfter = &after
+	}
+	if req.ReleasedBefore != nil {
+		before := req.ReleasedBefore.AsTime()
+		query.ReleasedBefore = &before
+	}
+	page := pagination.Request{PageSize: int(req.PageSize), PageToken: req.PageToken}
+	results, nextPageToken, err := h.svc.SearchMovies(ctx, query, page)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*catalog.MovieSearchResult, len(results))
+	for i, result := range results {
+		response[i] = &catalog.MovieSearchResult{
+			MovieId:              uint32(result.ID),
+			Title:                result.Title,
+			Description:          result.Description,
+			Duration:             int32(result.Duration),
+			Genre:                result.Genre,
+			ReleaseDate:          timestamppb.New(result.ReleaseDate),
+			Rating:               result.Rating,
+			Language:             result.Language,
+			Rank:                 result.Rank,
+			TitleHighlight:       result.TitleHighlight,
+			DescriptionHighlight: result.DescriptionHighlight,
+		}
+	}
+	return &catalog.SearchMoviesResponse{
+		Results:       response,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+var certificates = map[catalog.Certificate]string{
+	catalog.Certificate_U:  CertificateU,
+	catalog.Certificate_UA: CertificateUA,
+	catalog.Certificate_A:  CertificateA,
+}
+
+var creditRoles = map[catalog.CreditRole]string{
+	catalog.CreditRole_CAST: RoleCast,
+	catalog.CreditRole_CREW: RoleCrew,
+}
+
+var audioTypes = map[catalog.AudioType]string{
+	catalog.AudioType_ORIGINAL:  AudioOriginal,
+	catalog.AudioType_DUBBED:    AudioDubbed,
+	catalog.AudioType_SUBTITLED: AudioSubtitled,
+}
+
+// FormatNames maps catalog formats to the names stored in the database.
+var FormatNames = map[catalog.Format]string{
+	catalog.Format_TWO_D:   Format2D,
+	catalog.Format_THREE_D: Format3D,
+	catalog.Format_IMAX:    FormatIMAX,
+	catalog.Format_IMAX_3D: FormatIMAX3D,
+	catalog.Format_FOUR_DX: Format4DX,
+}
+
+var trackKinds = map[catalog.TrackKind]string{
+	catalog.TrackKind_AUDIO:    TrackAudio,
+	catalog.TrackKind_SUBTITLE: TrackSubtitle,
+}
+
+// ToCatalogFormat returns the catalog enum for a stored format name.
+func ToCatalogFormat(format string) catalog.Format {
+	return reverse(FormatNames, format)
+}
+
+// ToCatalogVersion converts a version for the catalog and scheduling APIs.
+func ToCatalogVersion(version MovieVersion) *catalog.MovieVersion {
+	return &catalog.MovieVersion{
+		VersionId:        uint32(version.ID),
+		MovieId:          uint32(version.MovieID),
+		Language:         version.Language,
+		AudioType:        reverse(audioTypes, version.AudioType),
+		SubtitleLanguage: version.SubtitleLanguage,
+		Format:           ToCatalogFormat(version.Format),
+	}
+}
+
+// reverse looks up the enum value for s, or the zero value.
+func reverse[E comparable](values map[E]string, s string) E {
+	for k, v := range values {
+		if v == s {
+			return k
+		}
+	}
+	var zero E
+	return zero
+}
+
+func toCatalogMovie(movie Movie) *catalog.Movie {
+	return &catalog.Movie{
+		MovieId:     uint32(movie.ID),
+		Title:       movie.Title,
+		Description: movie.Description,
+		Duration:    int32(movie.Duration),
+		Genre:       movie.Genre,
+		ReleaseDate: timestamppb.New(movie.ReleaseDate),
+		Rating:      movie.Rating,
+		Language:    movie.Language,
+		Certificate: reverse(certificates, movie.Certificate),
+		PosterUrl:   movie.PosterURL,
+		TrailerUrl:  movie.TrailerURL,
+	}
+}
+
+func toCatalogPerson(person Person) *catalog.Person {
+	res := &catalog.Person{
+		PersonId:  uint32(person.ID),
+		Name:      person.Name,
+		Biography: person.Biography,
+		ImageUrl:  person.ImageURL,
+	}
+	if person.BirthDate != nil {
+		res.BirthDate = timestamppb.New(*person.BirthDate)
+	}
+	return res
+}
+
+func fromCatalogPerson(person *catalog.Person) Person {
+	if person == nil {
+		return Person{}
+	}
+	res := Person{
+		Name:      person.Name,
+		Biography: person.Biography,
+		ImageURL:  person.ImageUrl,
+	}
+	if person.BirthDate != nil {
+		birthDate := person.BirthDate.AsTime()
+		res.BirthDate = &birthDate
+	}
+	return res
+}
+
+func (h *CatalogGrpcHandler) GetMovieDetails(ctx context.Context, req *catalog.GetMovieDetailsRequest) (*catalog.GetMovieDetailsResponse, error) {
+	movie, err := h.svc.GetMovieWithDetails(ctx, int(req.MovieId))
+	if err != nil {
+		return nil, err
+	}
+	genres := make([]*catalog.Genre, len(movie.Genres))
+	for i, genre := range movie.Genres {
+		genres[i] = &catalog.Genre{GenreId: uint32(genre.ID), Name: genre.Name}
+	}
+	credits := make([]*catalog.Credit, len(movie.Credits))
+	for i, credit := range movie.Credits {
+		credits[i] = &catalog.Credit{
+			PersonId:     uint32(credit.PersonID),
+			PersonName:   credit.Person.Name,
+			Role:         reverse(creditRoles, credit.Role),
+			Job:          credit.Job,
+			Character:    credit.Character,
+			BillingOrder: int32(credit.BillingOrder),
+		}
+	}
+	tracks := make([]*catalog.Track, len(movie.Tracks))
+	for i, track := range movie.Tracks {
+		tracks[i] = &catalog.Track{Kind: reverse(trackKinds, track.Kind), Language: track.Language}
+	}
+	versions := make([]*catalog.MovieVersion, len(movie.Versions))
+	for i, version := range movie.Versions {
+		versions[i] = ToCatalogVersion(version)
+	}
+	return &catalog.GetMovieDetailsResponse{
+		Movie:    toCatalogMovie(*movie),
+		Genres:   genres,
+		Credits:  credits,
+		Tracks:   tracks,
+		Versions: versions,
+	}, nil
+}
+
+func (h *CatalogGrpcHandler) SetMovieMetadata(ctx context.Context, req *catalog.SetMovieMetadataRequest) (*catalog.SetMovieMetadataResponse, error) {
+	metadata := MovieMetadata{
+		PosterURL:  req.PosterUrl,
+		TrailerURL: req.TrailerUrl,
+	}
+	if req.Certificate != catalog.Certificate_CERTIFICATE_UNSPECIFIED {
+		certificate, ok := certificates[req.Certificate]
+		if !ok {
+			return nil, apperrors.InvalidArgument("invalid certificate %v", req.Certificate)
+		}
+		metadata.Certificate = certificate
+	}
+	for _, track := range req.Tracks {
+		kind, ok := trackKinds[track.Kind]
+		if !ok {
+			return nil, apperrors.InvalidArgument("invalid track kind %v", track.Kind)
+		}
+		metadata.Tracks = append(metadata.Tracks, MovieTrack{Kind: kind, Language: track.Language})
+	}
+	if err := h.svc.SetMovieMetadata(ctx, int(req.MovieId), metadata); err != nil {
+		return nil, err
+	}
+	return &catalog.SetMovieMetadataResponse{}, nil
+}
+
+func (h *CatalogGrpcHandler) ListMoviesByPerson(ctx context.Context, req *catalog.ListMoviesByPersonRequest) (*catalog.ListMoviesByPersonResponse, error) {
+	role := ""
+	if req.Role != catalog.CreditRole_CREDIT_ROLE_UNSPECIFIED {
+		var ok bool
+		if role, ok = creditRoles[req.Role]; !ok {
+			return nil, apperrors.InvalidArgument("invalid credit role %v", req.Role)
+		}
+	}
+	page := pagination.Request{PageSize: int(req.PageSize), PageToken: req.PageToken}
+	movies, nextPageToken, err := h.svc.ListMoviesByPerson(ctx, int(req.PersonId), role, page)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*catalog.Movie, len(movies))
+	for i, movie := range movies {
+		response[i] = toCatalogMovie(movie)
+	}
+	return &catalog.ListMoviesByPersonResponse{
+		Movies:        response,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Genres
+func (h *CatalogGrpcHandler) CreateGenre(ctx context.Context, req *catalog.CreateGenreRequest) (*catalog.CreateGenreResponse, error) {
+	genreId, err := h.svc.CreateGenre(ctx, Genre{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.CreateGenreResponse{GenreId: uint32(genreId)}, nil
+}
+
+func (h *CatalogGrpcHandler) ListGenres(ctx context.Context, req *catalog.ListGenresRequest) (*catalog.ListGenresResponse, error) {
+	genres, err := h.svc.ListGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*catalog.Genre, len(genres))
+	for i, genre := range genres {
+		response[i] = &catalog.Genre{GenreId: uint32(genre.ID), Name: genre.Name}
+	}
+	return &catalog.ListGenresResponse{Genres: response}, nil
+}
+
+func (h *CatalogGrpcHandler) DeleteGenre(ctx context.Context, req *catalog.DeleteGenreRequest) (*catalog.DeleteGenreResponse, error) {
+	if err := h.svc.DeleteGenre(ctx, int(req.GenreId)); err != nil {
+		return nil, err
+	}
+	return &catalog.DeleteGenreResponse{}, nil
+}
+
+func (h *CatalogGrpcHandler) SetMovieGenres(ctx context.Context, req *catalog.SetMovieGenresRequest) (*catalog.SetMovieGenresResponse, error) {
+	genreIds := make([]uint, len(req.GenreIds))
+	for i, id := range req.GenreIds {
+		genreIds[i] = uint(id)
+	}
+	if err := h.svc.SetMovieGenres(ctx, int(req.MovieId), genreIds); err != nil {
+		return nil, err
+	}
+	return &catalog.SetMovieGenresResponse{}, nil
+}
+
+// People
+func (h *CatalogGrpcHandler) CreatePerson(ctx context.Context, req *catalog.CreatePersonRequest) (*catalog.CreatePersonResponse, error) {
+	personId, err := h.svc.CreatePerson(ctx, fromCatalogPerson(req.Person))
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.CreatePersonResponse{PersonId: uint32(personId)}, nil
+}
+
+func (h *CatalogGrpcHandler) UpdatePerson(ctx context.Context, req *catalog.UpdatePersonRequest) (*catalog.UpdatePersonResponse, error) {
+	if req.Person == nil {
+		return nil, apperrors.InvalidArgument("person is required")
+	}
+	if err := h.svc.UpdatePerson(ctx, fromCatalogPerson(req.Person), int(req.Person.PersonId)); err != nil {
+		return nil, err
+	}
+	return &catalog.UpdatePersonResponse{}, nil
+}
+
+func (h *CatalogGrpcHandler) GetPerson(ctx context.Context, req *catalog.GetPersonRequest) (*catalog.GetPersonResponse, error) {
+	person, err := h.svc.GetPerson(ctx, int(req.PersonId))
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.GetPersonResponse{Person: toCatalogPerson(*person)}, nil
+}
+
+func (h *CatalogGrpcHandler) ListPeople(ctx context.Context, req *catalog.ListPeopleRequest) (*catalog.ListPeopleResponse, error) {
+	page := pagination.Request{PageSize: int(req.PageSize), PageToken: req.PageToken}
+	people, nextPageToken, err := h.svc.ListPeople(ctx, req.Name, page)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*catalog.Person, len(people))
+	for i, person := range people {
+		response[i] = toCatalogPerson(person)
+	}
+	return &catalog.ListPeopleResponse{
+		People:        response,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *CatalogGrpcHandler) DeletePerson(ctx context.Context, req *catalog.DeletePersonRequest) (*catalog.DeletePersonResponse, error) {
+	if err := h.svc.DeletePerson(ctx, int(req.PersonId)); err != nil {
+		return nil, err
+	}
+	return &catalog.DeletePersonResponse{}, nil
+}
+
+func (h *CatalogGrpcHandler) SetMovieCredits(ctx context.Context, req *catalog.SetMovieCreditsRequest) (*catalog.SetMovieCreditsResponse, error) {
+	credits := make([]MovieCredit, len(req.Credits))
+	for i, credit := range req.Credits {
+		role, ok := creditRoles[credit.Role]
+		if !ok {
+			return nil, apperrors.InvalidArgument("credit for person %d needs a role", credit.PersonId)
+		}
+		credits[i] = MovieCredit{
+			PersonID:     uint(credit.PersonId),
+			Role:         role,
+			Job:          credit.Job,
+			Character:    credit.Character,
+			BillingOrder: int(credit.BillingOrder),
+		}
+	}
+	if err := h.svc.SetMovieCredits(ctx, int(req.MovieId), credits); err != nil {
+		return nil, err
+	}
+	return &catalog.SetMovieCreditsResponse{}, nil
+}
+
+// Versions
+func (h *CatalogGrpcHandler) CreateMovieVersion(ctx context.Context, req *catalog.CreateMovieVersionRequest) (*catalog.CreateMovieVersionResponse, error) {
+	if req.Version == nil {
+		return nil, apperrors.InvalidArgument("version is required")
+	}
+	audioType, ok := audioTypes[req.Version.AudioType]
+	if !ok {
+		return nil, apperrors.InvalidArgument("version needs an audio type")
+	}
+	format, ok := FormatNames[req.Version.Format]
+	if !ok {
+		return nil, apperrors.InvalidArgument("version needs a format")
+	}
+	versionId, err := h.svc.CreateMovieVersion(ctx, MovieVersion{
+		MovieID:          uint(req.Version.MovieId),
+		Language:         req.Version.Language,
+		AudioType:        audioType,
+		SubtitleLanguage: req.Version.SubtitleLanguage,
+		Format:           format,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.CreateMovieVersionResponse{VersionId: uint32(versionId)}, nil
+}
+
+func (h *CatalogGrpcHandler) ListMovieVersions(ctx context.Context, req *catalog.ListMovieVersionsRequest) (*catalog.ListMovieVersionsResponse, error) {
+	versions, err := h.svc.ListMovieVersions(ctx, int(req.MovieId))
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*catalog.MovieVersion, len(versions))
+	for i, version := range versions {
+		response[i] = ToCatalogVersion(version)
+	}
+	return &catalog.ListMovieVersionsResponse{Versions: response}, nil
+}
+
+func (h *CatalogGrpcHandler) DeleteMovieVersion(ctx context.Context, req *catalog.DeleteMovieVersionRequest) (*catalog.DeleteMovieVersionResponse, error) {
+	if err := h.svc.DeleteMovieVersion(ctx, int(req.VersionId)); err != nil {
+		return nil, err
+	}
+	return &catalog.DeleteMovieVersionResponse{}, nil
+}
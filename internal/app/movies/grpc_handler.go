@@ -2,10 +2,11 @@ package movies
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
 	"github.com/aparnasukesh/movies-booking-svc/pkg/utils"
 )
 
@@ -21,11 +22,17 @@ func NewGrpcHandler(svc Service) GrpcHandler {
 }
 
 func (h *GrpcHandler) GetMoviesByLanguage(ctx context.Context, req *movie_booking.GetMoviesByLanguageRequest) (*movie_booking.GetMoviesByLanguageResponse, error) {
-	var movies []Movie
-	movies, err := h.svc.GetMoviesByLanguage(ctx, req.Language)
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	movies, nextPageToken, err := h.svc.GetMoviesByLanguage(ctx, req.Language, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	response := []*movie_booking.Movie{}
 	for _, movie := range movies {
 		res := &movie_booking.Movie{
@@ -46,11 +53,17 @@ func (h *GrpcHandler) GetMoviesByLanguage(ctx context.Context, req *movie_bookin
 }
 
 func (h *GrpcHandler) GetMoviesByGenre(ctx context.Context, req *movie_booking.GetMoviesByGenreRequest) (*movie_booking.GetMoviesByGenreResponse, error) {
-	var movies []Movie
-	movies, err := h.svc.GetMoviesByGenre(ctx, req.Genre)
+	page, err := pagination.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	movies, nextPageToken, err := h.svc.GetMoviesByGenre(ctx, req.Genre, page)
 	if err != nil {
 		return nil, err
 	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	response := []*movie_booking.Movie{}
 	for _, movie := range movies {
 		res := &movie_booking.Movie{
@@ -128,7 +141,7 @@ func (h *GrpcHandler) UpdateMovie(ctx context.Context, req *movie_booking.Update
 	if req.ReleaseDate != "" {
 		date, err = utils.ParseDateString(req.ReleaseDate)
 		if err != nil {
-			return nil, fmt.Errorf("invalid date format for ReleaseDate: %w", err)
+			return nil, apperrors.InvalidArgument("invalid date format for ReleaseDate: %w", err)
 		}
 	}
 	movie := Movie{
@@ -153,10 +166,17 @@ func (h *GrpcHandler) UpdateMovie(ctx context.Context, req *movie_booking.Update
 }
 
 func (h *GrpcHandler) ListMovies(ctx context.Context, req *movie_booking.ListMoviesRequest) (*movie_booking.ListMoviesResponse, error) {
-	response, err := h.svc.ListMovies(ctx)
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	response, nextPageToken, err := h.svc.ListMovies(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var grpcMovies []*movie_booking.Movie
 	for _, m := range response {
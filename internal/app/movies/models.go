@@ -8,11 +8,125 @@ import (
 
 type Movie struct {
 	gorm.Model
-	Title       string    `gorm:"type:varchar(100);not null"`
-	Description string    `gorm:"type:text"`
-	Duration    int       `gorm:"not null"`
-	Genre       string    `gorm:"type:varchar(50)"`
-	ReleaseDate time.Time `gorm:"not null"`
-	Rating      float64   `gorm:"type:decimal(3,1)"`
-	Language    string    `gorm:"type:varchar(100);not null"`
+	Title       string         `gorm:"type:varchar(100);not null"`
+	Description string         `gorm:"type:text"`
+	Duration    int            `gorm:"not null"`
+	Genre       string         `gorm:"type:varchar(50)"`
+	ReleaseDate time.Time      `gorm:"not null"`
+	Rating      float64        `gorm:"type:decimal(3,1)"`
+	Language    string         `gorm:"type:varchar(100);not null"`
+	Certificate string         `gorm:"type:varchar(4)"`
+	PosterURL   string         `gorm:"type:text"`
+	TrailerURL  string         `gorm:"type:text"`
+	Genres      []Genre        `gorm:"many2many:movie_genres"`
+	Credits     []MovieCredit  `gorm:"foreignKey:MovieID"`
+	Tracks      []MovieTrack   `gorm:"foreignKey:MovieID"`
+	Versions    []MovieVersion `gorm:"foreignKey:MovieID"`
+}
+
+// Censor certificates
+const (
+	CertificateU  = "U"
+	CertificateUA = "UA"
+	CertificateA  = "A"
+)
+
+// Credit roles
+const (
+	RoleCast = "cast"
+	RoleCrew = "crew"
+)
+
+// Track kinds
+const (
+	TrackAudio    = "audio"
+	TrackSubtitle = "subtitle"
+)
+
+// Audio types
+const (
+	AudioOriginal  = "original"
+	AudioDubbed    = "dubbed"
+	AudioSubtitled = "subtitled"
+)
+
+// Formats
+const (
+	Format2D     = "2D"
+	Format3D     = "3D"
+	FormatIMAX   = "IMAX"
+	FormatIMAX3D = "IMAX 3D"
+	Format4DX    = "4DX"
+)
+
+// Formats lists every projection format in display order.
+var Formats = []string{Format2D, Format3D, FormatIMAX, FormatIMAX3D, Format4DX}
+
+// MovieVersion is a screenable cut of a movie. Showtimes reference a
+// version rather than the movie alone.
+type MovieVersion struct {
+	gorm.Model
+	MovieID          uint   `gorm:"not null"`
+	Language         string `gorm:"type:varchar(100);not null"`
+	AudioType        string `gorm:"type:varchar(10);not null"`
+	SubtitleLanguage string `gorm:"type:varchar(100)"`
+	Format           string `gorm:"type:varchar(10);not null"`
+}
+
+type Genre struct {
+	gorm.Model
+	Name string `gorm:"type:varchar(50);not null"`
+}
+
+type Person struct {
+	gorm.Model
+	Name      string `gorm:"type:varchar(100);not null"`
+	Biography string `gorm:"type:text"`
+	BirthDate *time.Time
+	ImageURL  string `gorm:"type:text"`
+}
+
+type MovieCredit struct {
+	ID           uint   `gorm:"primaryKey"`
+	MovieID      uint   `gorm:"not null"`
+	PersonID     uint   `gorm:"not null"`
+	Person       Person `gorm:"foreignKey:PersonID"`
+	Role         string `gorm:"type:varchar(10);not null"`
+	Job          string `gorm:"type:varchar(50)"`
+	Character    string `gorm:"type:varchar(100)"`
+	BillingOrder int    `gorm:"not null;default:0"`
+}
+
+type MovieTrack struct {
+	ID       uint   `gorm:"primaryKey"`
+	MovieID  uint   `gorm:"not null"`
+	Kind     string `gorm:"type:varchar(10);not null"`
+	Language string `gorm:"type:varchar(100);not null"`
+}
+
+// MovieMetadata is applied by SetMovieMetadata. Tracks replace the
+// existing ones.
+type MovieMetadata struct {
+	Certificate string
+	PosterURL   string
+	TrailerURL  string
+	Tracks      []MovieTrack
+}
+
+// SearchQuery selects movies for SearchMovies. Empty fields do not filter.
+type SearchQuery struct {
+	Text           string
+	Languages      []string
+	Genres         []string
+	MinRating      *float64
+	MaxRating      *float64
+	ReleasedAfter  *time.Time
+	ReleasedBefore *time.Time
+}
+
+type SearchResult struct {
+	Movie
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
 }
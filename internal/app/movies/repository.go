@@ -2,9 +2,13 @@ package movies
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"strings"
 
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -16,14 +20,75 @@ type Repository interface {
 	CreateMovie(ctx context.Context, movie Movie) (int, error)
 	DeleteMovie(ctx context.Context, movieId int) error
 	UpdateMovie(ctx context.Context, movie Movie, movieId int) error
-	GetMovies(ctx context.Context) ([]Movie, error)
+	GetMovies(ctx context.Context, page pagination.Request) (*pagination.Page[Movie], error)
 	GetMovieDetailsById(ctx context.Context, movieId int) (*Movie, error)
-	GetMoviesByLanguage(ctx context.Context, language string) ([]Movie, error)
-	GetMoviesByGenre(ctx context.Context, genre string) ([]Movie, error)
+	GetMoviesByLanguage(ctx context.Context, language string, page pagination.Request) (*pagination.Page[Movie], error)
+	GetMoviesByGenre(ctx context.Context, genre string, page pagination.Request) (*pagination.Page[Movie], error)
 	GetMovieByName(ctx context.Context, name string) (*Movie, error)
 	GetMovieByNameAndLanguage(ctx context.Context, name, language string) (*Movie, error)
+	SearchMovies(ctx context.Context, query SearchQuery, page pagination.Request) (*pagination.Page[SearchResult], error)
+	GetMovieWithDetails(ctx context.Context, movieId int) (*Movie, error)
+	SetMovieMetadata(ctx context.Context, movieId int, metadata MovieMetadata) error
+	ListMoviesByPerson(ctx context.Context, personId int, role string, page pagination.Request) (*pagination.Page[Movie], error)
+	// Genres
+	CreateGenre(ctx context.Context, genre Genre) (int, error)
+	FindGenreByName(ctx context.Context, name string) (*Genre, error)
+	GetGenresByIDs(ctx context.Context, ids []uint) ([]Genre, error)
+	ListGenres(ctx context.Context) ([]Genre, error)
+	DeleteGenre(ctx context.Context, genreId int) error
+	SetMovieGenres(ctx context.Context, movieId int, genres []Genre) error
+	// People
+	CreatePerson(ctx context.Context, person Person) (int, error)
+	UpdatePerson(ctx context.Context, person Person, personId int) error
+	GetPersonByID(ctx context.Context, personId int) (*Person, error)
+	GetPeopleByIDs(ctx context.Context, ids []uint) ([]Person, error)
+	ListPeople(ctx context.Context, name string, page pagination.Request) (*pagination.Page[Person], error)
+	DeletePerson(ctx context.Context, personId int) error
+	SetMovieCredits(ctx context.Context, movieId int, credits []MovieCredit) error
+	// Versions
+	CreateMovieVersion(ctx context.Context, version MovieVersion) (int, error)
+	FindMovieVersion(ctx context.Context, version MovieVersion) (*MovieVersion, error)
+	GetMovieVersionByID(ctx context.Context, versionId int) (*MovieVersion, error)
+	GetDefaultMovieVersion(ctx context.Context, movieId int) (*MovieVersion, error)
+	ListMovieVersions(ctx context.Context, movieId int) ([]MovieVersion, error)
+	DeleteMovieVersion(ctx context.Context, versionId int) error
 }
 
+var movieListSpec = pagination.Spec[Movie]{
+	Fields: map[string]pagination.Field[Movie]{
+		"title":        {Column: "title", Type: pagination.String, Value: func(m Movie) interface{} { return m.Title }},
+		"language":     {Column: "language", Type: pagination.String, Value: func(m Movie) interface{} { return m.Language }},
+		"genre":        {Column: "COALESCE(genre, '')", Type: pagination.String, Value: func(m Movie) interface{} { return m.Genre }},
+		"duration":     {Column: "duration", Type: pagination.Int, Value: func(m Movie) interface{} { return m.Duration }},
+		"release_date": {Column: "release_date", Type: pagination.Time, Value: func(m Movie) interface{} { return m.ReleaseDate }},
+		"rating":       {Column: "COALESCE(rating, 0)", Type: pagination.Float, Value: func(m Movie) interface{} { return m.Rating }},
+	},
+	DefaultOrder: "title",
+	ID:           pagination.Field[Movie]{Column: "id", Type: pagination.Int, Value: func(m Movie) interface{} { return m.ID }},
+}
+
+var personListSpec = pagination.Spec[Person]{
+	Fields: map[string]pagination.Field[Person]{
+		"name": {Column: "name", Type: pagination.String, Value: func(p Person) interface{} { return p.Name }},
+	},
+	DefaultOrder: "name",
+	ID:           pagination.Field[Person]{Column: "id", Type: pagination.Int, Value: func(p Person) interface{} { return p.ID }},
+}
+
+// movieGenre is a row of the movie_genres join table.
+type movieGenre struct {
+	MovieID uint
+	GenreID uint
+}
+
+func (movieGenre) TableName() string {
+	return "movie_genres"
+}
+
+// genreMovies selects the ids of movies linked to a genre by name.
+const genreMovies = `SELECT mg.movie_id FROM movie_genres mg
+	JOIN genres g ON g.id = mg.genre_id AND g.deleted_at IS NULL`
+
 func NewRepository(db *gorm.DB) Repository {
 	return &repository{
 		db: db,
@@ -33,7 +98,7 @@ func NewRepository(db *gorm.DB) Repository {
 func (r *repository) GetMovieByNameAndLanguage(ctx context.Context, name, language string) (*Movie, error) {
 	movieData := &Movie{}
 
-	result := r.db.Where("title ILIKE ? AND language ILIKE ?", name, language).First(&movieData)
+	result := r.db.WithContext(ctx).Where("title ILIKE ? AND language ILIKE ?", name, language).First(&movieData)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -46,7 +111,7 @@ func (r *repository) GetMovieByNameAndLanguage(ctx context.Context, name, langua
 func (r *repository) FindMovieByNameAndLanguage(ctx context.Context, movie Movie) (*Movie, error) {
 	movieData := &Movie{}
 
-	result := r.db.Where("title ILIKE ? AND language ILIKE ?", movie.Title, movie.Language).First(&movieData)
+	result := r.db.WithContext(ctx).Where("title ILIKE ? AND language ILIKE ?", movie.Title, movie.Language).First(&movieData)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -56,44 +121,50 @@ func (r *repository) FindMovieByNameAndLanguage(ctx context.Context, movie Movie
 	return movieData, nil
 }
 
+// CreateMovie also creates the movie's default version: its original
+// language in 2D.
 func (r *repository) CreateMovie(ctx context.Context, movie Movie) (int, error) {
-	if err := r.db.Create(&movie).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&movie).Error; err != nil {
+			return err
+		}
+		return tx.Create(&MovieVersion{
+			MovieID:   movie.ID,
+			Language:  movie.Language,
+			AudioType: AudioOriginal,
+			Format:    Format2D,
+		}).Error
+	})
+	if err != nil {
 		return 0, err
 	}
 	return int(movie.ID), nil
 }
 func (r *repository) DeleteMovie(ctx context.Context, movieId int) error {
 	movie := Movie{}
-	result := r.db.Where("id=?", movieId).Delete(&movie)
+	result := r.db.WithContext(ctx).Where("id=?", movieId).Delete(&movie)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no movie found with ID %d", movieId)
+		return apperrors.NotFound("no movie found with ID %d", movieId)
 	}
 	return nil
 }
 
 func (r *repository) GetMovieDetailsById(ctx context.Context, movieId int) (*Movie, error) {
 	movie := Movie{}
-	if err := r.db.Where("id=?", movieId).First(&movie).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id=?", movieId).First(&movie).Error; err != nil {
 		return nil, err
 	}
 	return &movie, nil
 }
 
-func (r *repository) GetMovies(ctx context.Context) ([]Movie, error) {
-	movies := []Movie{}
-	res := r.db.Find(&movies)
-	if res.Error != nil {
-		return nil, res.Error
-	}
-	return movies, nil
+func (r *repository) GetMovies(ctx context.Context, page pagination.Request) (*pagination.Page[Movie], error) {
+	return pagination.List(r.db.WithContext(ctx), movieListSpec, page)
 }
 func (r *repository) UpdateMovie(ctx context.Context, movie Movie, movieId int) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
-
-	result := r.db.Model(&Movie{}).Where("id = ?", movieId).Updates(movie)
+	result := r.db.WithContext(ctx).Model(&Movie{}).Omit(clause.Associations).Where("id = ?", movieId).Updates(movie)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -105,30 +176,338 @@ func (r *repository) UpdateMovie(ctx context.Context, movie Movie, movieId int)
 
 func (r *repository) GetMovieByName(ctx context.Context, name string) (*Movie, error) {
 	movie := Movie{}
-	result := r.db.Where("title = ?", name).First(&movie)
+	result := r.db.WithContext(ctx).Where("title = ?", name).First(&movie)
 	if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
 		return nil, result.Error
 	}
 	if result.Error == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("no movie found with name %s", name)
+		return nil, apperrors.NotFound("no movie found with name %s", name)
 	}
 	return &movie, nil
 }
 
-func (r *repository) GetMoviesByGenre(ctx context.Context, genre string) ([]Movie, error) {
-	movies := []Movie{}
-	result := r.db.Where("genre ILIKE ?", genre).Find(&movies)
+func (r *repository) GetMoviesByGenre(ctx context.Context, genre string, page pagination.Request) (*pagination.Page[Movie], error) {
+	query := r.db.WithContext(ctx).Where("(genre ILIKE ? OR id IN ("+genreMovies+" WHERE g.name ILIKE ?))", genre, genre)
+	return pagination.List(query, movieListSpec, page)
+}
+
+func (r *repository) GetMoviesByLanguage(ctx context.Context, language string, page pagination.Request) (*pagination.Page[Movie], error) {
+	return pagination.List(r.db.WithContext(ctx).Where("language ILIKE ?", language), movieListSpec, page)
+}
+
+// searchSelect ranks full-text matches on the weighted search_vector and
+// adds trigram similarity so that misspelled titles, genres and
+// descriptions still score.
+const searchSelect = `movies.*,
+	ts_rank_cd(search_vector, websearch_to_tsquery('english', @text))
+		+ word_similarity(@text, title)
+		+ 0.5 * word_similarity(@text, coalesce(genre, ''))
+		+ 0.25 * word_similarity(@text, coalesce(description, '')) AS rank,
+	ts_headline('english', title, websearch_to_tsquery('english', @text),
+		'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS title_highlight,
+	ts_headline('english', coalesce(description, ''), websearch_to_tsquery('english', @text),
+		'StartSel=<b>, StopSel=</b>, MaxFragments=2, MinWords=8, MaxWords=25') AS description_highlight`
+
+// searchMatch uses the GIN indexes from the movie search migrations. <% is
+// pg_trgm's word similarity operator, which tolerates typos. The columns are
+// compared bare so the trigram indexes apply. GORM only parenthesizes
+// conditions containing " OR " with spaces, which the line breaks here
+// avoid, so the match is wrapped explicitly to keep the filters and the
+// soft delete check ANDed onto every branch.
+const searchMatch = `(search_vector @@ websearch_to_tsquery('english', @text)
+	OR @text <% title
+	OR @text <% genre
+	OR @text <% description)`
+
+func (r *repository) SearchMovies(ctx context.Context, query SearchQuery, page pagination.Request) (*pagination.Page[SearchResult], error) {
+	db := r.db.WithContext(ctx).Model(&Movie{})
+	if query.Text != "" {
+		text := sql.Named("text", query.Text)
+		db = db.Select(searchSelect, text).Where(searchMatch, text).Order("rank DESC")
+	} else {
+		db = db.Select("movies.*, 0 AS rank, title AS title_highlight, coalesce(description, '') AS description_highlight").
+			Order("release_date DESC")
+	}
+	if len(query.Languages) > 0 {
+		db = db.Where("lower(language) IN ?", lowerAll(query.Languages))
+	}
+	if len(query.Genres) > 0 {
+		genres := lowerAll(query.Genres)
+		db = db.Where("(lower(genre) IN ? OR movies.id IN ("+genreMovies+" WHERE lower(g.name) IN ?))", genres, genres)
+	}
+	if query.MinRating != nil {
+		db = db.Where("rating >= ?", *query.MinRating)
+	}
+	if query.MaxRating != nil {
+		db = db.Where("rating <= ?", *query.MaxRating)
+	}
+	if query.ReleasedAfter != nil {
+		db = db.Where("release_date >= ?", *query.ReleasedAfter)
+	}
+	if query.ReleasedBefore != nil {
+		db = db.Where("release_date <= ?", *query.ReleasedBefore)
+	}
+	return pagination.ListOffset[SearchResult](db.Order("movies.id"), page)
+}
+
+func lowerAll(values []string) []string {
+	lowered := make([]string, len(values))
+	for i, v := range values {
+		lowered[i] = strings.ToLower(v)
+	}
+	return lowered
+}
+
+func (r *repository) GetMovieWithDetails(ctx context.Context, movieId int) (*Movie, error) {
+	movie := Movie{}
+	err := r.db.WithContext(ctx).
+		Preload("Genres").
+		Preload("Credits", func(db *gorm.DB) *gorm.DB { return db.Order("billing_order, id") }).
+		Preload("Credits.Person").
+		Preload("Tracks", func(db *gorm.DB) *gorm.DB { return db.Order("kind, language") }).
+		Preload("Versions", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Where("id = ?", movieId).First(&movie).Error
+	if err != nil {
+		return nil, err
+	}
+	return &movie, nil
+}
+
+func (r *repository) SetMovieMetadata(ctx context.Context, movieId int, metadata MovieMetadata) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Movie{}).Where("id = ?", movieId).Updates(map[string]interface{}{
+			"certificate": metadata.Certificate,
+			"poster_url":  metadata.PosterURL,
+			"trailer_url": metadata.TrailerURL,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("movie_id = ?", movieId).Delete(&MovieTrack{}).Error; err != nil {
+			return err
+		}
+		if len(metadata.Tracks) == 0 {
+			return nil
+		}
+		for i := range metadata.Tracks {
+			metadata.Tracks[i].MovieID = uint(movieId)
+		}
+		return tx.Create(&metadata.Tracks).Error
+	})
+}
+
+func (r *repository) ListMoviesByPerson(ctx context.Context, personId int, role string, page pagination.Request) (*pagination.Page[Movie], error) {
+	credits := r.db.Model(&MovieCredit{}).Select("movie_id").Where("person_id = ?", personId)
+	if role != "" {
+		credits = credits.Where("role = ?", role)
+	}
+	return pagination.List(r.db.WithContext(ctx).Where("id IN (?)", credits), movieListSpec, page)
+}
+
+// Genres
+func (r *repository) CreateGenre(ctx context.Context, genre Genre) (int, error) {
+	if err := r.db.WithContext(ctx).Create(&genre).Error; err != nil {
+		return 0, err
+	}
+	return int(genre.ID), nil
+}
+
+func (r *repository) FindGenreByName(ctx context.Context, name string) (*Genre, error) {
+	genre := Genre{}
+	if err := r.db.WithContext(ctx).Where("lower(name) = lower(?)", name).First(&genre).Error; err != nil {
+		return nil, err
+	}
+	return &genre, nil
+}
+
+func (r *repository) GetGenresByIDs(ctx context.Context, ids []uint) ([]Genre, error) {
+	genres := []Genre{}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&genres).Error; err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+func (r *repository) ListGenres(ctx context.Context) ([]Genre, error) {
+	genres := []Genre{}
+	if err := r.db.WithContext(ctx).Order("name").Find(&genres).Error; err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+func (r *repository) DeleteGenre(ctx context.Context, genreId int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", genreId).Delete(&Genre{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.NotFound("no genre found with ID %d", genreId)
+		}
+		return tx.Where("genre_id = ?", genreId).Delete(&movieGenre{}).Error
+	})
+}
+
+// SetMovieGenres replaces the genres of a movie and stores the first one
+// in the genre column as the primary genre.
+func (r *repository) SetMovieGenres(ctx context.Context, movieId int, genres []Genre) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		primary := ""
+		if len(genres) > 0 {
+			primary = genres[0].Name
+		}
+		result := tx.Model(&Movie{}).Where("id = ?", movieId).Update("genre", primary)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("movie_id = ?", movieId).Delete(&movieGenre{}).Error; err != nil {
+			return err
+		}
+		if len(genres) == 0 {
+			return nil
+		}
+		rows := make([]movieGenre, len(genres))
+		for i, genre := range genres {
+			rows[i] = movieGenre{MovieID: uint(movieId), GenreID: genre.ID}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// People
+func (r *repository) CreatePerson(ctx context.Context, person Person) (int, error) {
+	if err := r.db.WithContext(ctx).Create(&person).Error; err != nil {
+		return 0, err
+	}
+	return int(person.ID), nil
+}
+
+func (r *repository) UpdatePerson(ctx context.Context, person Person, personId int) error {
+	result := r.db.WithContext(ctx).Model(&Person{}).Where("id = ?", personId).Updates(person)
 	if result.Error != nil {
-		return nil, result.Error
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
-	return movies, nil
+	return nil
+}
+
+func (r *repository) GetPersonByID(ctx context.Context, personId int) (*Person, error) {
+	person := Person{}
+	if err := r.db.WithContext(ctx).Where("id = ?", personId).First(&person).Error; err != nil {
+		return nil, err
+	}
+	return &person, nil
+}
+
+func (r *repository) GetPeopleByIDs(ctx context.Context, ids []uint) ([]Person, error) {
+	people := []Person{}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&people).Error; err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+func (r *repository) ListPeople(ctx context.Context, name string, page pagination.Request) (*pagination.Page[Person], error) {
+	query := r.db.WithContext(ctx)
+	if name != "" {
+		query = query.Where("name ILIKE ?", "%"+name+"%")
+	}
+	return pagination.List(query, personListSpec, page)
+}
+
+func (r *repository) DeletePerson(ctx context.Context, personId int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", personId).Delete(&Person{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.NotFound("no person found with ID %d", personId)
+		}
+		return tx.Where("person_id = ?", personId).Delete(&MovieCredit{}).Error
+	})
 }
 
-func (r *repository) GetMoviesByLanguage(ctx context.Context, language string) ([]Movie, error) {
-	movies := []Movie{}
-	result := r.db.Where("language ILIKE ?", language).Find(&movies)
+func (r *repository) SetMovieCredits(ctx context.Context, movieId int, credits []MovieCredit) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("movie_id = ?", movieId).Delete(&MovieCredit{}).Error; err != nil {
+			return err
+		}
+		if len(credits) == 0 {
+			return nil
+		}
+		for i := range credits {
+			credits[i].MovieID = uint(movieId)
+		}
+		return tx.Omit("Person").Create(&credits).Error
+	})
+}
+
+// Versions
+func (r *repository) CreateMovieVersion(ctx context.Context, version MovieVersion) (int, error) {
+	if err := r.db.WithContext(ctx).Create(&version).Error; err != nil {
+		return 0, err
+	}
+	return int(version.ID), nil
+}
+
+func (r *repository) FindMovieVersion(ctx context.Context, version MovieVersion) (*MovieVersion, error) {
+	found := MovieVersion{}
+	err := r.db.WithContext(ctx).
+		Where("movie_id = ? AND lower(language) = lower(?) AND audio_type = ? AND lower(coalesce(subtitle_language, '')) = lower(?) AND format = ?",
+			version.MovieID, version.Language, version.AudioType, version.SubtitleLanguage, version.Format).
+		First(&found).Error
+	if err != nil {
+		return nil, err
+	}
+	return &found, nil
+}
+
+func (r *repository) GetMovieVersionByID(ctx context.Context, versionId int) (*MovieVersion, error) {
+	version := MovieVersion{}
+	if err := r.db.WithContext(ctx).Where("id = ?", versionId).First(&version).Error; err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+// GetDefaultMovieVersion prefers the original audio in 2D, then the oldest
+// version.
+func (r *repository) GetDefaultMovieVersion(ctx context.Context, movieId int) (*MovieVersion, error) {
+	version := MovieVersion{}
+	err := r.db.WithContext(ctx).Where("movie_id = ?", movieId).
+		Order("audio_type = 'original' DESC, format = '2D' DESC, id").
+		First(&version).Error
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func (r *repository) ListMovieVersions(ctx context.Context, movieId int) ([]MovieVersion, error) {
+	versions := []MovieVersion{}
+	if err := r.db.WithContext(ctx).Where("movie_id = ?", movieId).Order("id").Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (r *repository) DeleteMovieVersion(ctx context.Context, versionId int) error {
+	result := r.db.WithContext(ctx).Where("id = ?", versionId).Delete(&MovieVersion{})
 	if result.Error != nil {
-		return nil, result.Error
+		return result.Error
 	}
-	return movies, nil
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("no movie version found with ID %d", versionId)
+	}
+	return nil
 }
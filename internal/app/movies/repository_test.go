@@ -0,0 +1,69 @@
+package movies
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds SQL without a database and hands each query to capture.
+func dryRunDB(t *testing.T, capture func(sql string)) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	err = db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		capture(tx.Statement.SQL.String())
+	})
+	if err != nil {
+		t.Fatalf("register capture callback: %v", err)
+	}
+	return db
+}
+
+func TestSearchMoviesGroupsTextMatch(t *testing.T) {
+	var query string
+	repo := NewRepository(dryRunDB(t, func(sql string) { query = sql }))
+
+	minRating := 7.5
+	after := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := repo.SearchMovies(context.Background(), SearchQuery{
+		Text:          "matrix",
+		Languages:     []string{"English"},
+		MinRating:     &minRating,
+		ReleasedAfter: &after,
+	}, pagination.Request{})
+	if err != nil {
+		t.Fatalf("SearchMovies: %v", err)
+	}
+
+	where := query[strings.Index(query, " WHERE "):]
+	match := strings.Index(where, "(search_vector @@")
+	end := strings.Index(where, "<% description)")
+	if match < 0 || end < 0 {
+		t.Fatalf("text match is not parenthesized: %s", where)
+	}
+	for _, filter := range []string{"lower(language) IN", "rating >=", "release_date >=", `"movies"."deleted_at" IS NULL`} {
+		i := strings.Index(where, filter)
+		if i < 0 {
+			t.Fatalf("missing filter %q: %s", filter, where)
+		}
+		if i > match && i < end {
+			t.Errorf("filter %q is inside the text match: %s", filter, where)
+		}
+	}
+	for _, column := range []string{"<% title", "<% genre", "<% description"} {
+		if !strings.Contains(where, column) {
+			t.Errorf("text match does not use %q: %s", column, where)
+		}
+	}
+}
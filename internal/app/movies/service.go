@@ -5,8 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/rbac"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
@@ -14,32 +22,57 @@ import (
 type service struct {
 	repo        Repository
 	redisClient *redis.Client
+	rbac        rbac.Service
+	metrics     *metrics.Metrics
 }
 
 type Service interface {
 	RegisterMovie(ctx context.Context, movie Movie) (int, error)
 	UpdateMovie(ctx context.Context, movie Movie, movieId int) error
-	ListMovies(ctx context.Context) ([]Movie, error)
+	ListMovies(ctx context.Context, page pagination.Request) ([]Movie, string, error)
 	GetMovieDetailsByID(ctx context.Context, movieId int) (*Movie, error)
 	DeleteMovie(ctx context.Context, movieId int) error
-	GetMoviesByLanguage(ctx context.Context, language string) ([]Movie, error)
-	GetMoviesByGenre(ctx context.Context, genre string) ([]Movie, error)
+	GetMoviesByLanguage(ctx context.Context, language string, page pagination.Request) ([]Movie, string, error)
+	GetMoviesByGenre(ctx context.Context, genre string, page pagination.Request) ([]Movie, string, error)
 	GetMovieByName(ctx context.Context, name string) (*Movie, error)
 	GetMovieByNameAndLanguage(ctx context.Context, name, language string) (*Movie, error)
+	SearchMovies(ctx context.Context, query SearchQuery, page pagination.Request) ([]SearchResult, string, error)
+	GetMovieWithDetails(ctx context.Context, movieId int) (*Movie, error)
+	SetMovieMetadata(ctx context.Context, movieId int, metadata MovieMetadata) error
+	ListMoviesByPerson(ctx context.Context, personId int, role string, page pagination.Request) ([]Movie, string, error)
+	// Genres
+	CreateGenre(ctx context.Context, genre Genre) (int, error)
+	ListGenres(ctx context.Context) ([]Genre, error)
+	DeleteGenre(ctx context.Context, genreId int) error
+	SetMovieGenres(ctx context.Context, movieId int, genreIds []uint) error
+	// People
+	CreatePerson(ctx context.Context, person Person) (int, error)
+	UpdatePerson(ctx context.Context, person Person, personId int) error
+	GetPerson(ctx context.Context, personId int) (*Person, error)
+	ListPeople(ctx context.Context, name string, page pagination.Request) ([]Person, string, error)
+	DeletePerson(ctx context.Context, personId int) error
+	SetMovieCredits(ctx context.Context, movieId int, credits []MovieCredit) error
+	// Versions
+	CreateMovieVersion(ctx context.Context, version MovieVersion) (int, error)
+	ListMovieVersions(ctx context.Context, movieId int) ([]MovieVersion, error)
+	DeleteMovieVersion(ctx context.Context, versionId int) error
 	// Redis
 	GetFromCache(ctx context.Context, cacheKey string, result interface{}) error
 	SetToCache(ctx context.Context, cacheKey string, data interface{}, expiration time.Duration) error
 }
 
-func NewService(repo Repository, redisClient *redis.Client) Service {
+func NewService(repo Repository, redisClient *redis.Client, rbacSvc rbac.Service, metrics *metrics.Metrics) Service {
 	return &service{
 		repo:        repo,
 		redisClient: redisClient,
+		rbac:        rbacSvc,
+		metrics:     metrics,
 	}
 }
 
 func (s *service) GetFromCache(ctx context.Context, cacheKey string, result interface{}) error {
 	val, err := s.redisClient.Get(ctx, cacheKey).Result()
+	s.metrics.CacheLookup("movies", err == nil)
 	if err != nil {
 		return err
 	}
@@ -67,7 +100,17 @@ func (s *service) SetToCache(ctx context.Context, cacheKey string, data interfac
 }
 
 // Movies
+func (s *service) authorizeCatalog(ctx context.Context) error {
+	if _, err := s.rbac.Authorize(ctx, rbac.PermManageCatalog); err != nil {
+		return fmt.Errorf("unauthorized: only super admins can manage movies: %w", err)
+	}
+	return nil
+}
+
 func (s *service) RegisterMovie(ctx context.Context, movie Movie) (int, error) {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return 0, err
+	}
 	res, err := s.repo.FindMovieByNameAndLanguage(ctx, movie)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -75,7 +118,7 @@ func (s *service) RegisterMovie(ctx context.Context, movie Movie) (int, error) {
 		}
 	}
 	if res != nil && err == nil {
-		return 0, errors.New("this movie already exist")
+		return 0, apperrors.AlreadyExists("this movie already exist")
 	}
 	movieId, err := s.repo.CreateMovie(ctx, movie)
 	if err != nil {
@@ -85,6 +128,9 @@ func (s *service) RegisterMovie(ctx context.Context, movie Movie) (int, error) {
 }
 
 func (s *service) DeleteMovie(ctx context.Context, movieId int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	err := s.repo.DeleteMovie(ctx, movieId)
 	if err != nil {
 		return err
@@ -93,7 +139,7 @@ func (s *service) DeleteMovie(ctx context.Context, movieId int) error {
 	cacheKey := fmt.Sprintf("movie:%d", movieId)
 	err = s.redisClient.Del(ctx, cacheKey).Err()
 	if err != nil {
-		fmt.Printf("Failed to invalidate cache for movie %d: %v\n", movieId, err)
+		logger.FromContext(ctx).Warn("failed to invalidate movie cache", "movie_id", movieId, "error", err)
 	}
 
 	return nil
@@ -104,21 +150,21 @@ func (s *service) GetMovieDetailsByID(ctx context.Context, movieId int) (*Movie,
 	var cachedMovie Movie
 	err := s.GetFromCache(ctx, cacheKey, &cachedMovie)
 	if err == nil {
-		fmt.Println("Movie found in cache")
+		logger.FromContext(ctx).Debug("movie found in cache", "cache_key", cacheKey)
 		return &cachedMovie, nil
 	} else if err != redis.Nil {
-		fmt.Printf("Redis error: %v\n", err)
+		logger.FromContext(ctx).Warn("failed to read movie cache", "cache_key", cacheKey, "error", err)
 	}
 	movie, err := s.repo.GetMovieDetailsById(ctx, movieId)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	}
 	if err != nil && err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("movie not found with the id %d", movieId)
+		return nil, apperrors.NotFound("movie not found with the id %d", movieId)
 	}
 	err = s.SetToCache(ctx, cacheKey, movie, 10*time.Minute)
 	if err != nil {
-		fmt.Printf("Failed to cache movie details: %v\n", err)
+		logger.FromContext(ctx).Warn("failed to cache movie details", "cache_key", cacheKey, "error", err)
 	}
 	return movie, nil
 }
@@ -128,21 +174,21 @@ func (s *service) GetMovieByName(ctx context.Context, name string) (*Movie, erro
 	var cachedMovie Movie
 	err := s.GetFromCache(ctx, cacheKey, &cachedMovie)
 	if err == nil {
-		fmt.Println("Movie found in cache")
+		logger.FromContext(ctx).Debug("movie found in cache", "cache_key", cacheKey)
 		return &cachedMovie, nil
 	} else if err != redis.Nil {
-		fmt.Printf("Redis error: %v\n", err)
+		logger.FromContext(ctx).Warn("failed to read movie cache", "cache_key", cacheKey, "error", err)
 	}
 	movie, err := s.repo.GetMovieByName(ctx, name)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	}
 	if err != nil && err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("movie not found with the id %d", name)
+		return nil, apperrors.NotFound("movie not found with the name %s", name)
 	}
 	err = s.SetToCache(ctx, cacheKey, movie, 10*time.Minute)
 	if err != nil {
-		fmt.Printf("Failed to cache movie details: %v\n", err)
+		logger.FromContext(ctx).Warn("failed to cache movie details", "cache_key", cacheKey, "error", err)
 	}
 	return movie, nil
 }
@@ -151,38 +197,41 @@ func (s *service) GetMovieByNameAndLanguage(ctx context.Context, name, language
 	var cachedMovie Movie
 	err := s.GetFromCache(ctx, cacheKey, &cachedMovie)
 	if err == nil {
-		fmt.Println("Movie found in cache")
+		logger.FromContext(ctx).Debug("movie found in cache", "cache_key", cacheKey)
 		return &cachedMovie, nil
 	} else if err != redis.Nil {
-		fmt.Printf("Redis error: %v\n", err)
+		logger.FromContext(ctx).Warn("failed to read movie cache", "cache_key", cacheKey, "error", err)
 	}
 	movie, err := s.repo.GetMovieByNameAndLanguage(ctx, name, language)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	}
 	if err != nil && err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("movie not found with the name '%s' and language '%s'", name, language)
+		return nil, apperrors.NotFound("movie not found with the name '%s' and language '%s'", name, language)
 	}
 	err = s.SetToCache(ctx, cacheKey, movie, 10*time.Minute)
 	if err != nil {
-		fmt.Printf("Failed to cache movie details: %v\n", err)
+		logger.FromContext(ctx).Warn("failed to cache movie details", "cache_key", cacheKey, "error", err)
 	}
 
 	return movie, nil
 }
 
-func (s *service) ListMovies(ctx context.Context) ([]Movie, error) {
-	movies, err := s.repo.GetMovies(ctx)
+func (s *service) ListMovies(ctx context.Context, page pagination.Request) ([]Movie, string, error) {
+	movies, err := s.repo.GetMovies(ctx, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(movies) < 1 {
-		return nil, errors.New("no movies found")
+	if len(movies.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movies found")
 	}
-	return movies, nil
+	return movies.Items, movies.NextPageToken, nil
 }
 
 func (s *service) UpdateMovie(ctx context.Context, movie Movie, movieId int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	err := s.repo.UpdateMovie(ctx, movie, movieId)
 	if err != nil {
 		return err
@@ -190,30 +239,370 @@ func (s *service) UpdateMovie(ctx context.Context, movie Movie, movieId int) err
 	cacheKey := fmt.Sprintf("movie:%d", movieId)
 	err = s.redisClient.Del(ctx, cacheKey).Err()
 	if err != nil {
-		fmt.Printf("Failed to invalidate cache for movie %d: %v\n", movieId, err)
+		logger.FromContext(ctx).Warn("failed to invalidate movie cache", "movie_id", movieId, "error", err)
+	}
+
+	return nil
+}
+
+func (s *service) GetMoviesByGenre(ctx context.Context, genre string, page pagination.Request) ([]Movie, string, error) {
+	movies, err := s.repo.GetMoviesByGenre(ctx, genre, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(movies.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movies found in this genre %s", genre)
+	}
+	return movies.Items, movies.NextPageToken, nil
+}
+
+func (s *service) GetMoviesByLanguage(ctx context.Context, language string, page pagination.Request) ([]Movie, string, error) {
+	movies, err := s.repo.GetMoviesByLanguage(ctx, language, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(movies.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movies found in this language  %s", language)
+	}
+	return movies.Items, movies.NextPageToken, nil
+}
+
+const maxSearchTextLength = 200
+
+func (s *service) SearchMovies(ctx context.Context, query SearchQuery, page pagination.Request) ([]SearchResult, string, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if len(query.Text) > maxSearchTextLength {
+		return nil, "", apperrors.InvalidArgument("search query must be at most %d characters", maxSearchTextLength)
+	}
+	if query.Text == "" && len(query.Languages) == 0 && len(query.Genres) == 0 && query.MinRating == nil &&
+		query.MaxRating == nil && query.ReleasedAfter == nil && query.ReleasedBefore == nil {
+		return nil, "", apperrors.InvalidArgument("search needs a query or at least one filter")
+	}
+	if query.MinRating != nil && query.MaxRating != nil && *query.MinRating > *query.MaxRating {
+		return nil, "", apperrors.InvalidArgument("min rating %.1f is greater than max rating %.1f", *query.MinRating, *query.MaxRating)
+	}
+	if query.ReleasedAfter != nil && query.ReleasedBefore != nil && query.ReleasedAfter.After(*query.ReleasedBefore) {
+		return nil, "", apperrors.InvalidArgument("released after must not be later than released before")
+	}
+	results, err := s.repo.SearchMovies(ctx, query, page)
+	if err != nil {
+		return nil, "", err
+	}
+	return results.Items, results.NextPageToken, nil
+}
+
+func (s *service) invalidateMovie(ctx context.Context, movieId int) {
+	cacheKey := fmt.Sprintf("movie:%d", movieId)
+	if err := s.redisClient.Del(ctx, cacheKey).Err(); err != nil {
+		logger.FromContext(ctx).Warn("failed to invalidate movie cache", "movie_id", movieId, "error", err)
+	}
+}
+
+func (s *service) GetMovieWithDetails(ctx context.Context, movieId int) (*Movie, error) {
+	movie, err := s.repo.GetMovieWithDetails(ctx, movieId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("movie not found with the id %d", movieId)
+		}
+		return nil, err
+	}
+	return movie, nil
+}
+
+func (s *service) SetMovieMetadata(ctx context.Context, movieId int, metadata MovieMetadata) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	switch metadata.Certificate {
+	case "", CertificateU, CertificateUA, CertificateA:
+	default:
+		return apperrors.InvalidArgument("invalid certificate %q", metadata.Certificate)
+	}
+	for _, link := range []string{metadata.PosterURL, metadata.TrailerURL} {
+		if err := validateURL(link); err != nil {
+			return err
+		}
+	}
+	seen := map[string]bool{}
+	for i, track := range metadata.Tracks {
+		if track.Kind != TrackAudio && track.Kind != TrackSubtitle {
+			return apperrors.InvalidArgument("invalid track kind %q", track.Kind)
+		}
+		metadata.Tracks[i].Language = strings.TrimSpace(track.Language)
+		if metadata.Tracks[i].Language == "" {
+			return apperrors.InvalidArgument("track language is required")
+		}
+		key := track.Kind + ":" + strings.ToLower(metadata.Tracks[i].Language)
+		if seen[key] {
+			return apperrors.InvalidArgument("duplicate %s track for %s", track.Kind, metadata.Tracks[i].Language)
+		}
+		seen[key] = true
+	}
+	if err := s.repo.SetMovieMetadata(ctx, movieId, metadata); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("movie not found with the id %d", movieId)
+		}
+		return err
+	}
+	s.invalidateMovie(ctx, movieId)
+	return nil
+}
+
+func validateURL(link string) error {
+	if link == "" {
+		return nil
+	}
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apperrors.InvalidArgument("invalid url %q", link)
+	}
+	return nil
+}
+
+func (s *service) ListMoviesByPerson(ctx context.Context, personId int, role string, page pagination.Request) ([]Movie, string, error) {
+	if role != "" && role != RoleCast && role != RoleCrew {
+		return nil, "", apperrors.InvalidArgument("invalid credit role %q", role)
+	}
+	if _, err := s.GetPerson(ctx, personId); err != nil {
+		return nil, "", err
+	}
+	movies, err := s.repo.ListMoviesByPerson(ctx, personId, role, page)
+	if err != nil {
+		return nil, "", err
+	}
+	return movies.Items, movies.NextPageToken, nil
+}
+
+// Genres
+func (s *service) CreateGenre(ctx context.Context, genre Genre) (int, error) {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return 0, err
+	}
+	genre.Name = strings.TrimSpace(genre.Name)
+	if genre.Name == "" || len(genre.Name) > 50 {
+		return 0, apperrors.InvalidArgument("genre name must be 1 to 50 characters")
+	}
+	res, err := s.repo.FindGenreByName(ctx, genre.Name)
+	if res != nil && err == nil {
+		return 0, apperrors.AlreadyExists("genre %s already exist", genre.Name)
+	}
+	if err != gorm.ErrRecordNotFound {
+		return 0, err
 	}
+	return s.repo.CreateGenre(ctx, genre)
+}
+
+func (s *service) ListGenres(ctx context.Context) ([]Genre, error) {
+	return s.repo.ListGenres(ctx)
+}
+
+func (s *service) DeleteGenre(ctx context.Context, genreId int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	return s.repo.DeleteGenre(ctx, genreId)
+}
 
+func (s *service) SetMovieGenres(ctx context.Context, movieId int, genreIds []uint) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	ids := []uint{}
+	seen := map[uint]bool{}
+	for _, id := range genreIds {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	genres := []Genre{}
+	if len(ids) > 0 {
+		found, err := s.repo.GetGenresByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		byID := map[uint]Genre{}
+		for _, genre := range found {
+			byID[genre.ID] = genre
+		}
+		for _, id := range ids {
+			genre, ok := byID[id]
+			if !ok {
+				return apperrors.NotFound("no genre found with ID %d", id)
+			}
+			genres = append(genres, genre)
+		}
+	}
+	if err := s.repo.SetMovieGenres(ctx, movieId, genres); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("movie not found with the id %d", movieId)
+		}
+		return err
+	}
+	s.invalidateMovie(ctx, movieId)
 	return nil
 }
 
-func (s *service) GetMoviesByGenre(ctx context.Context, genre string) ([]Movie, error) {
-	movies, err := s.repo.GetMoviesByGenre(ctx, genre)
+// People
+func validatePerson(person Person) error {
+	if strings.TrimSpace(person.Name) == "" || len(person.Name) > 100 {
+		return apperrors.InvalidArgument("person name must be 1 to 100 characters")
+	}
+	return validateURL(person.ImageURL)
+}
+
+func (s *service) CreatePerson(ctx context.Context, person Person) (int, error) {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return 0, err
+	}
+	person.Name = strings.TrimSpace(person.Name)
+	if err := validatePerson(person); err != nil {
+		return 0, err
+	}
+	return s.repo.CreatePerson(ctx, person)
+}
+
+func (s *service) UpdatePerson(ctx context.Context, person Person, personId int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	person.Name = strings.TrimSpace(person.Name)
+	if err := validatePerson(person); err != nil {
+		return err
+	}
+	if err := s.repo.UpdatePerson(ctx, person, personId); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("person not found with the id %d", personId)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *service) GetPerson(ctx context.Context, personId int) (*Person, error) {
+	person, err := s.repo.GetPersonByID(ctx, personId)
 	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("person not found with the id %d", personId)
+		}
 		return nil, err
 	}
-	if len(movies) < 1 {
-		return nil, fmt.Errorf("no movies found in this genre %s", genre)
+	return person, nil
+}
+
+func (s *service) ListPeople(ctx context.Context, name string, page pagination.Request) ([]Person, string, error) {
+	people, err := s.repo.ListPeople(ctx, strings.TrimSpace(name), page)
+	if err != nil {
+		return nil, "", err
+	}
+	return people.Items, people.NextPageToken, nil
+}
+
+func (s *service) DeletePerson(ctx context.Context, personId int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	return s.repo.DeletePerson(ctx, personId)
+}
+
+func (s *service) SetMovieCredits(ctx context.Context, movieId int, credits []MovieCredit) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetMovieDetailsById(ctx, movieId); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("movie not found with the id %d", movieId)
+		}
+		return err
+	}
+	ids := []uint{}
+	seen := map[uint]bool{}
+	for _, credit := range credits {
+		if credit.Role != RoleCast && credit.Role != RoleCrew {
+			return apperrors.InvalidArgument("invalid credit role %q for person %d", credit.Role, credit.PersonID)
+		}
+		if !seen[credit.PersonID] {
+			seen[credit.PersonID] = true
+			ids = append(ids, credit.PersonID)
+		}
+	}
+	if len(ids) > 0 {
+		people, err := s.repo.GetPeopleByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if len(people) != len(ids) {
+			found := map[uint]bool{}
+			for _, person := range people {
+				found[person.ID] = true
+			}
+			for _, id := range ids {
+				if !found[id] {
+					return apperrors.NotFound("person not found with the id %d", id)
+				}
+			}
+		}
 	}
-	return movies, nil
+	return s.repo.SetMovieCredits(ctx, movieId, credits)
 }
 
-func (s *service) GetMoviesByLanguage(ctx context.Context, language string) ([]Movie, error) {
-	movies, err := s.repo.GetMoviesByLanguage(ctx, language)
+// Versions
+func (s *service) CreateMovieVersion(ctx context.Context, version MovieVersion) (int, error) {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return 0, err
+	}
+	version.Language = strings.TrimSpace(version.Language)
+	version.SubtitleLanguage = strings.TrimSpace(version.SubtitleLanguage)
+	if version.Language == "" {
+		return 0, apperrors.InvalidArgument("version language is required")
+	}
+	switch version.AudioType {
+	case AudioOriginal, AudioDubbed:
+		if version.SubtitleLanguage != "" {
+			return 0, apperrors.InvalidArgument("only subtitled versions have a subtitle language")
+		}
+	case AudioSubtitled:
+		if version.SubtitleLanguage == "" {
+			return 0, apperrors.InvalidArgument("subtitled versions need a subtitle language")
+		}
+	default:
+		return 0, apperrors.InvalidArgument("invalid audio type %q", version.AudioType)
+	}
+	if !slices.Contains(Formats, version.Format) {
+		return 0, apperrors.InvalidArgument("invalid format %q", version.Format)
+	}
+	if _, err := s.repo.GetMovieDetailsById(ctx, int(version.MovieID)); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return 0, apperrors.NotFound("movie not found with the id %d", version.MovieID)
+		}
+		return 0, err
+	}
+	res, err := s.repo.FindMovieVersion(ctx, version)
+	if res != nil && err == nil {
+		return 0, apperrors.AlreadyExists("this movie version already exist")
+	}
+	if err != gorm.ErrRecordNotFound {
+		return 0, err
+	}
+	return s.repo.CreateMovieVersion(ctx, version)
+}
+
+func (s *service) ListMovieVersions(ctx context.Context, movieId int) ([]MovieVersion, error) {
+	versions, err := s.repo.ListMovieVersions(ctx, movieId)
 	if err != nil {
 		return nil, err
 	}
-	if len(movies) < 1 {
-		return nil, fmt.Errorf("no movies found in this language  %s", language)
+	if len(versions) < 1 {
+		return nil, apperrors.NotFound("no versions found for movie %d", movieId)
+	}
+	return versions, nil
+}
+
+// DeleteMovieVersion keeps existing showtimes of the version; new ones can
+// no longer be scheduled.
+func (s *service) DeleteMovieVersion(ctx context.Context, versionId int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
 	}
-	return movies, nil
+	return s.repo.DeleteMovieVersion(ctx, versionId)
 }
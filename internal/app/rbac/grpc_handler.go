@@ -0,0 +1,77 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	pb "github.com/aparnasukesh/movies-booking-svc/pkg/pb/rbac"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcHandler struct {
+	svc Service
+	pb.UnimplementedStaffServiceServer
+}
+
+func NewGrpcHandler(svc Service) GrpcHandler {
+	return GrpcHandler{
+		svc: svc,
+	}
+}
+
+var theaterRolesToPb = map[TheaterRole]pb.TheaterRole{
+	TheaterRoleStaff:     pb.TheaterRole_THEATER_ROLE_STAFF,
+	TheaterRoleBoxOffice: pb.TheaterRole_THEATER_ROLE_BOX_OFFICE,
+}
+
+func (h *GrpcHandler) AssignTheaterStaff(ctx context.Context, req *pb.AssignTheaterStaffRequest) (*pb.AssignTheaterStaffResponse, error) {
+	var role TheaterRole
+	for r, pbRole := range theaterRolesToPb {
+		if pbRole == req.Role {
+			role = r
+		}
+	}
+	if role == "" {
+		return nil, apperrors.InvalidArgument("invalid theater role %s", req.Role)
+	}
+	assignment, err := h.svc.AssignTheaterStaff(ctx, StaffAssignment{
+		TheaterID: uint(req.TheaterId),
+		AdminID:   uint(req.AdminId),
+		Role:      role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AssignTheaterStaffResponse{
+		Assignment: toPbAssignment(assignment),
+	}, nil
+}
+
+func (h *GrpcHandler) RemoveTheaterStaff(ctx context.Context, req *pb.RemoveTheaterStaffRequest) (*pb.RemoveTheaterStaffResponse, error) {
+	if err := h.svc.RemoveTheaterStaff(ctx, uint(req.TheaterId), uint(req.AdminId)); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveTheaterStaffResponse{}, nil
+}
+
+func (h *GrpcHandler) ListTheaterStaff(ctx context.Context, req *pb.ListTheaterStaffRequest) (*pb.ListTheaterStaffResponse, error) {
+	assignments, err := h.svc.ListTheaterStaff(ctx, uint(req.TheaterId))
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListTheaterStaffResponse{}
+	for i := range assignments {
+		response.Assignments = append(response.Assignments, toPbAssignment(&assignments[i]))
+	}
+	return response, nil
+}
+
+func toPbAssignment(assignment *StaffAssignment) *pb.StaffAssignment {
+	return &pb.StaffAssignment{
+		TheaterId:  uint32(assignment.TheaterID),
+		AdminId:    uint32(assignment.AdminID),
+		Role:       theaterRolesToPb[assignment.Role],
+		AssignedBy: uint32(assignment.AssignedBy),
+		AssignedAt: timestamppb.New(assignment.UpdatedAt),
+	}
+}
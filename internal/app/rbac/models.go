@@ -0,0 +1,23 @@
+package rbac
+
+import "time"
+
+// TheaterRole is a role held by an admin within a single theater. Theater
+// owners are not assigned a role; ownership comes from Theater.OwnerID.
+type TheaterRole string
+
+const (
+	TheaterRoleOwner     TheaterRole = "owner"
+	TheaterRoleStaff     TheaterRole = "staff"
+	TheaterRoleBoxOffice TheaterRole = "box-office"
+)
+
+type StaffAssignment struct {
+	ID         uint        `gorm:"primarykey"`
+	TheaterID  uint        `gorm:"uniqueIndex:idx_staff_theater_admin" json:"theater_id"`
+	AdminID    uint        `gorm:"uniqueIndex:idx_staff_theater_admin;index" json:"admin_id"`
+	Role       TheaterRole `json:"role"`
+	AssignedBy uint        `json:"assigned_by"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,37 @@
+package rbac
+
+type Permission string
+
+const (
+	// Global permissions.
+	PermManageCatalog Permission = "catalog:manage"
+	PermCreateTheater Permission = "theater:create"
+
+	// Theater permissions.
+	PermManageTheater  Permission = "theater:manage"
+	PermManageStaff    Permission = "theater:staff:manage"
+	PermManageShows    Permission = "theater:shows:manage"
+	PermViewBookings   Permission = "theater:bookings:view"
+	PermCheckInTickets Permission = "theater:check-in"
+)
+
+// theaterPermissions lists what each theater role may do. Super admins hold
+// every permission and are not listed.
+var theaterPermissions = map[TheaterRole][]Permission{
+	TheaterRoleOwner:     {PermManageTheater, PermManageStaff, PermManageShows, PermViewBookings, PermCheckInTickets},
+	TheaterRoleStaff:     {PermManageShows, PermViewBookings, PermCheckInTickets},
+	TheaterRoleBoxOffice: {PermViewBookings, PermCheckInTickets},
+}
+
+func (r TheaterRole) Can(permission Permission) bool {
+	for _, p := range theaterPermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func (r TheaterRole) Assignable() bool {
+	return r == TheaterRoleStaff || r == TheaterRoleBoxOffice
+}
@@ -0,0 +1,77 @@
+package rbac
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+type Repository interface {
+	GetTheaterOwnerID(ctx context.Context, theaterId uint) (uint, error)
+	GetAssignment(ctx context.Context, theaterId, adminId uint) (*StaffAssignment, error)
+	UpsertAssignment(ctx context.Context, assignment *StaffAssignment) error
+	DeleteAssignment(ctx context.Context, theaterId, adminId uint) error
+	ListAssignmentsByTheater(ctx context.Context, theaterId uint) ([]StaffAssignment, error)
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// GetTheaterOwnerID reads the owner straight from the theaters table so that
+// rbac does not depend on the theatres module.
+func (r *repository) GetTheaterOwnerID(ctx context.Context, theaterId uint) (uint, error) {
+	var owner struct {
+		OwnerID uint
+	}
+	res := r.db.WithContext(ctx).Table("theaters").Select("owner_id").Where("id = ? AND deleted_at IS NULL", theaterId).Take(&owner)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return owner.OwnerID, nil
+}
+
+func (r *repository) GetAssignment(ctx context.Context, theaterId, adminId uint) (*StaffAssignment, error) {
+	assignment := &StaffAssignment{}
+	if err := r.db.WithContext(ctx).Where("theater_id = ? AND admin_id = ?", theaterId, adminId).First(assignment).Error; err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+func (r *repository) UpsertAssignment(ctx context.Context, assignment *StaffAssignment) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "theater_id"}, {Name: "admin_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "assigned_by", "updated_at"}),
+	}).Create(assignment).Error
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *repository) DeleteAssignment(ctx context.Context, theaterId, adminId uint) error {
+	res := r.db.WithContext(ctx).Where("theater_id = ? AND admin_id = ?", theaterId, adminId).Delete(&StaffAssignment{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *repository) ListAssignmentsByTheater(ctx context.Context, theaterId uint) ([]StaffAssignment, error) {
+	assignments := []StaffAssignment{}
+	if err := r.db.WithContext(ctx).Where("theater_id = ?", theaterId).Order("id").Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
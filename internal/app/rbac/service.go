@@ -0,0 +1,126 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"gorm.io/gorm"
+)
+
+type service struct {
+	repo Repository
+}
+
+type Service interface {
+	// Authorize checks a global permission.
+	Authorize(ctx context.Context, permission Permission) (auth.Identity, error)
+	// AuthorizeTheater checks a permission on a single theater.
+	AuthorizeTheater(ctx context.Context, theaterId uint, permission Permission) (auth.Identity, error)
+	AssignTheaterStaff(ctx context.Context, assignment StaffAssignment) (*StaffAssignment, error)
+	RemoveTheaterStaff(ctx context.Context, theaterId, adminId uint) error
+	ListTheaterStaff(ctx context.Context, theaterId uint) ([]StaffAssignment, error)
+}
+
+func NewService(repo Repository) Service {
+	return &service{
+		repo: repo,
+	}
+}
+
+func (s *service) Authorize(ctx context.Context, permission Permission) (auth.Identity, error) {
+	switch permission {
+	case PermManageCatalog:
+		return auth.RequireRole(ctx, auth.RoleSuperAdmin)
+	case PermCreateTheater:
+		return auth.RequireRole(ctx, auth.RoleAdmin)
+	}
+	return auth.Identity{}, fmt.Errorf("%s is not a global permission", permission)
+}
+
+func (s *service) AuthorizeTheater(ctx context.Context, theaterId uint, permission Permission) (auth.Identity, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return identity, err
+	}
+	if identity.IsSuperAdmin() {
+		return identity, nil
+	}
+	if identity.Role != auth.RoleAdmin {
+		return identity, auth.ErrPermissionDenied
+	}
+	role, err := s.theaterRole(ctx, theaterId, identity.UserID)
+	if err != nil {
+		return identity, err
+	}
+	if !role.Can(permission) {
+		return identity, auth.ErrPermissionDenied
+	}
+	return identity, nil
+}
+
+// theaterRole resolves the role an admin holds in a theater.
+func (s *service) theaterRole(ctx context.Context, theaterId, adminId uint) (TheaterRole, error) {
+	ownerId, err := s.repo.GetTheaterOwnerID(ctx, theaterId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", apperrors.NotFound("no theater found with id %d", theaterId)
+		}
+		return "", err
+	}
+	if ownerId == adminId {
+		return TheaterRoleOwner, nil
+	}
+	assignment, err := s.repo.GetAssignment(ctx, theaterId, adminId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", auth.ErrPermissionDenied
+		}
+		return "", err
+	}
+	return assignment.Role, nil
+}
+
+func (s *service) AssignTheaterStaff(ctx context.Context, assignment StaffAssignment) (*StaffAssignment, error) {
+	if !assignment.Role.Assignable() {
+		return nil, apperrors.InvalidArgument("role %q cannot be assigned to theater staff", assignment.Role)
+	}
+	identity, err := s.AuthorizeTheater(ctx, assignment.TheaterID, PermManageStaff)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: cannot manage staff of theater %d: %w", assignment.TheaterID, err)
+	}
+	ownerId, err := s.repo.GetTheaterOwnerID(ctx, assignment.TheaterID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerId == assignment.AdminID {
+		return nil, apperrors.FailedPrecondition("the theater owner cannot be assigned as staff")
+	}
+	assignment.AssignedBy = identity.UserID
+	if err := s.repo.UpsertAssignment(ctx, &assignment); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAssignment(ctx, assignment.TheaterID, assignment.AdminID)
+}
+
+func (s *service) RemoveTheaterStaff(ctx context.Context, theaterId, adminId uint) error {
+	if _, err := s.AuthorizeTheater(ctx, theaterId, PermManageStaff); err != nil {
+		return fmt.Errorf("unauthorized: cannot manage staff of theater %d: %w", theaterId, err)
+	}
+	if err := s.repo.DeleteAssignment(ctx, theaterId, adminId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NotFound("admin %d is not assigned to theater %d", adminId, theaterId)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *service) ListTheaterStaff(ctx context.Context, theaterId uint) ([]StaffAssignment, error) {
+	if _, err := s.AuthorizeTheater(ctx, theaterId, PermManageStaff); err != nil {
+		return nil, fmt.Errorf("unauthorized: cannot view staff of theater %d: %w", theaterId, err)
+	}
+	return s.repo.ListAssignmentsByTheater(ctx, theaterId)
+}
@@ -0,0 +1,176 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"gorm.io/gorm"
+)
+
+const (
+	theaterID      = 1
+	otherTheaterID = 2
+	ownerID        = 7
+	staffID        = 8
+	boxOfficeID    = 9
+	// otherOwnerID owns otherTheaterID and has no part in theaterID.
+	otherOwnerID = 10
+)
+
+type fakeRepo struct {
+	Repository
+	owners      map[uint]uint
+	assignments map[[2]uint]TheaterRole
+	err         error
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		owners: map[uint]uint{theaterID: ownerID, otherTheaterID: otherOwnerID},
+		assignments: map[[2]uint]TheaterRole{
+			{theaterID, staffID}:     TheaterRoleStaff,
+			{theaterID, boxOfficeID}: TheaterRoleBoxOffice,
+		},
+	}
+}
+
+func (f *fakeRepo) GetTheaterOwnerID(ctx context.Context, theaterId uint) (uint, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	ownerId, ok := f.owners[theaterId]
+	if !ok {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return ownerId, nil
+}
+
+func (f *fakeRepo) GetAssignment(ctx context.Context, theaterId, adminId uint) (*StaffAssignment, error) {
+	role, ok := f.assignments[[2]uint{theaterId, adminId}]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &StaffAssignment{TheaterID: theaterId, AdminID: adminId, Role: role}, nil
+}
+
+func as(userId uint, role auth.Role) context.Context {
+	return auth.WithIdentity(context.Background(), auth.Identity{UserID: userId, Role: role})
+}
+
+var theaterPerms = []Permission{PermManageTheater, PermManageStaff, PermManageShows, PermViewBookings, PermCheckInTickets}
+
+func TestAuthorizeTheater(t *testing.T) {
+	callers := []struct {
+		name    string
+		ctx     context.Context
+		allowed []Permission
+		// denied is the error for the permissions not in allowed.
+		denied apperrors.Kind
+	}{
+		{"super admin", as(1, auth.RoleSuperAdmin), theaterPerms, apperrors.KindPermissionDenied},
+		{"owner", as(ownerID, auth.RoleAdmin), theaterPerms, apperrors.KindPermissionDenied},
+		{"staff", as(staffID, auth.RoleAdmin), []Permission{PermManageShows, PermViewBookings, PermCheckInTickets}, apperrors.KindPermissionDenied},
+		{"box office", as(boxOfficeID, auth.RoleAdmin), []Permission{PermViewBookings, PermCheckInTickets}, apperrors.KindPermissionDenied},
+		{"owner of another theater", as(otherOwnerID, auth.RoleAdmin), nil, apperrors.KindPermissionDenied},
+		// Users and admins have separate ids, so a user sharing the owner's
+		// id must not pass as the owner.
+		{"user with the owner's id", as(ownerID, auth.RoleUser), nil, apperrors.KindPermissionDenied},
+		{"service", as(ownerID, auth.RoleService), nil, apperrors.KindPermissionDenied},
+		{"no identity", context.Background(), nil, apperrors.KindUnauthenticated},
+	}
+	for _, caller := range callers {
+		allowed := map[Permission]bool{}
+		for _, p := range caller.allowed {
+			allowed[p] = true
+		}
+		for _, permission := range theaterPerms {
+			t.Run(caller.name+"/"+string(permission), func(t *testing.T) {
+				svc := NewService(newFakeRepo())
+				_, err := svc.AuthorizeTheater(caller.ctx, theaterID, permission)
+				if allowed[permission] {
+					if err != nil {
+						t.Fatalf("AuthorizeTheater = %v, want allowed", err)
+					}
+				} else if !apperrors.Is(err, caller.denied) {
+					t.Fatalf("AuthorizeTheater = %v, want %v", err, caller.denied)
+				}
+			})
+		}
+	}
+}
+
+func TestAuthorizeTheaterRolesDoNotCarryAcrossTheaters(t *testing.T) {
+	svc := NewService(newFakeRepo())
+	for _, adminId := range []uint{ownerID, staffID, boxOfficeID} {
+		for _, permission := range theaterPerms {
+			_, err := svc.AuthorizeTheater(as(adminId, auth.RoleAdmin), otherTheaterID, permission)
+			if !apperrors.Is(err, apperrors.KindPermissionDenied) {
+				t.Errorf("admin %d on theater %d with %s = %v, want permission denied", adminId, otherTheaterID, permission, err)
+			}
+		}
+	}
+}
+
+func TestAuthorizeTheaterErrors(t *testing.T) {
+	svc := NewService(newFakeRepo())
+	if _, err := svc.AuthorizeTheater(as(ownerID, auth.RoleAdmin), 99, PermManageTheater); !apperrors.Is(err, apperrors.KindNotFound) {
+		t.Errorf("unknown theater = %v, want not found", err)
+	}
+
+	// Global permissions are not granted through a theater role.
+	for _, permission := range []Permission{PermManageCatalog, PermCreateTheater} {
+		if _, err := svc.AuthorizeTheater(as(ownerID, auth.RoleAdmin), theaterID, permission); !apperrors.Is(err, apperrors.KindPermissionDenied) {
+			t.Errorf("owner with %s = %v, want permission denied", permission, err)
+		}
+	}
+
+	repo := newFakeRepo()
+	repo.err = errors.New("connection refused")
+	_, err := NewService(repo).AuthorizeTheater(as(ownerID, auth.RoleAdmin), theaterID, PermManageTheater)
+	if !errors.Is(err, repo.err) {
+		t.Errorf("repository failure = %v, want %v", err, repo.err)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       auth.Role
+		permission Permission
+		allowed    bool
+	}{
+		{"super admin manages catalog", auth.RoleSuperAdmin, PermManageCatalog, true},
+		{"super admin creates theater", auth.RoleSuperAdmin, PermCreateTheater, true},
+		{"admin manages catalog", auth.RoleAdmin, PermManageCatalog, false},
+		{"admin creates theater", auth.RoleAdmin, PermCreateTheater, true},
+		{"user manages catalog", auth.RoleUser, PermManageCatalog, false},
+		{"user creates theater", auth.RoleUser, PermCreateTheater, false},
+		{"service manages catalog", auth.RoleService, PermManageCatalog, false},
+		{"service creates theater", auth.RoleService, PermCreateTheater, false},
+	}
+	svc := NewService(newFakeRepo())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Authorize(as(1, tt.role), tt.permission)
+			if tt.allowed && err != nil {
+				t.Fatalf("Authorize = %v, want allowed", err)
+			}
+			if !tt.allowed && !apperrors.Is(err, apperrors.KindPermissionDenied) {
+				t.Fatalf("Authorize = %v, want permission denied", err)
+			}
+		})
+	}
+
+	if _, err := svc.Authorize(context.Background(), PermCreateTheater); !apperrors.Is(err, apperrors.KindUnauthenticated) {
+		t.Errorf("no identity = %v, want unauthenticated", err)
+	}
+	// A theater permission is never granted globally, not even to super admins.
+	for _, permission := range theaterPerms {
+		if _, err := svc.Authorize(as(1, auth.RoleSuperAdmin), permission); err == nil {
+			t.Errorf("Authorize(%s) succeeded, want error", permission)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package theatres
 import (
 	"context"
 
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+
 	"github.com/aparnasukesh/inter-communication/movie_booking"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -20,10 +22,17 @@ func NewGrpcHandler(svc Service) GrpcHandler {
 
 // Show time
 func (h *GrpcHandler) ListShowTimeByTheaterIDandMovieID(ctx context.Context, req *movie_booking.ListShowTimeByTheaterIdandMovieIdRequest) (*movie_booking.ListShowTimeByTheaterIdandMovieIdResponse, error) {
-	showtimes, theater, movie, err := h.svc.ListShowTimeByTheaterIDandMovieID(ctx, int(req.TheaterId), int(req.MovieId))
+	page, err := pagination.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	showtimes, theater, movie, nextPageToken, err := h.svc.ListShowTimeByTheaterIDandMovieID(ctx, int(req.TheaterId), int(req.MovieId), page)
 	if err != nil {
 		return nil, err
 	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	theaterRes := movie_booking.Theater{
 		TheaterId:       int32(theater.ID),
 		Name:            theater.Name,
@@ -84,10 +93,17 @@ func (h *GrpcHandler) ListShowTimeByTheaterIDandMovieID(ctx context.Context, req
 }
 
 func (h *GrpcHandler) ListShowTimeByTheaterID(ctx context.Context, req *movie_booking.ListShowTimeByTheaterIdRequest) (*movie_booking.ListShowTimeByTheaterIdResponse, error) {
-	showtimes, theater, err := h.svc.ListShowTimeByTheaterID(ctx, int(req.TheaterId))
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	showtimes, theater, nextPageToken, err := h.svc.ListShowTimeByTheaterID(ctx, int(req.TheaterId), page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	theaterRes := movie_booking.Theater{
 		TheaterId:       int32(theater.ID),
 		Name:            theater.Name,
@@ -140,10 +156,17 @@ func (h *GrpcHandler) ListShowTimeByTheaterID(ctx context.Context, req *movie_bo
 
 // Theaters
 func (h *GrpcHandler) GetTheatersByCity(ctx context.Context, req *movie_booking.GetTheatersByCityRequest) (*movie_booking.GetTheatersByCityResponse, error) {
-	theaters, err := h.svc.GetTheatersByCity(ctx, req.City)
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	theaters, nextPageToken, err := h.svc.GetTheatersByCity(ctx, req.City, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	response := []*movie_booking.Theater{}
 
 	for _, theater := range theaters {
@@ -323,7 +346,7 @@ func (h *GrpcHandler) CreateSeats(ctx context.Context, req *movie_booking.Create
 		TotalRows:    int(req.TotalRows),
 		TotalColumns: int(req.TotalColumns),
 		SeatRequest:  rowSeatCatetoryPrice,
-	}, int(req.OwnerId))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -475,7 +498,7 @@ func (h *GrpcHandler) AddMovieSchedule(ctx context.Context, req *movie_booking.A
 		MovieID:    int(req.MovieSchedule.MovieId),
 		TheaterID:  int(req.MovieSchedule.TheaterId),
 		ShowtimeID: int(req.MovieSchedule.ShowtimeId),
-	}, int(req.OwnerId))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -487,7 +510,7 @@ func (h *GrpcHandler) UpdateMovieSchedule(ctx context.Context, req *movie_bookin
 		MovieID:    int(req.MovieSchedule.MovieId),
 		TheaterID:  int(req.MovieSchedule.TheaterId),
 		ShowtimeID: int(req.MovieSchedule.ShowtimeId),
-	}, int(req.OwnerId))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -495,10 +518,17 @@ func (h *GrpcHandler) UpdateMovieSchedule(ctx context.Context, req *movie_bookin
 }
 
 func (h *GrpcHandler) GetAllMovieSchedules(ctx context.Context, req *movie_booking.GetAllMovieScheduleRequest) (*movie_booking.GetAllMovieScheduleResponse, error) {
-	movieSchedules, err := h.svc.GetAllMovieSchedules(ctx)
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	movieSchedules, nextPageToken, err := h.svc.GetAllMovieSchedules(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var scheduleList []*movie_booking.MovieSchedule
 	for _, schedule := range movieSchedules {
@@ -513,10 +543,17 @@ func (h *GrpcHandler) GetAllMovieSchedules(ctx context.Context, req *movie_booki
 }
 
 func (h *GrpcHandler) GetMovieScheduleByMovieID(ctx context.Context, req *movie_booking.GetMovieScheduleByMovieIdRequest) (*movie_booking.GetMovieScheduleByMovieIdResponse, error) {
-	movieSchedules, err := h.svc.GetMovieScheduleByMovieID(ctx, int(req.MovieId))
+	page, err := pagination.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	movieSchedules, nextPageToken, err := h.svc.GetMovieScheduleByMovieID(ctx, int(req.MovieId), page)
 	if err != nil {
 		return nil, err
 	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var scheduleList []*movie_booking.MovieSchedule
 	for _, schedule := range movieSchedules {
@@ -531,10 +568,17 @@ func (h *GrpcHandler) GetMovieScheduleByMovieID(ctx context.Context, req *movie_
 }
 
 func (h *GrpcHandler) GetMovieScheduleByTheaterID(ctx context.Context, req *movie_booking.GetMovieScheduleByTheaterIdRequest) (*movie_booking.GetMovieScheduleByTheaterIdResponse, error) {
-	movieSchedules, err := h.svc.GetMovieScheduleByTheaterID(ctx, int(req.TheaterId))
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	movieSchedules, nextPageToken, err := h.svc.GetMovieScheduleByTheaterID(ctx, int(req.TheaterId), page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var scheduleList []*movie_booking.MovieSchedule
 	for _, schedule := range movieSchedules {
@@ -549,10 +593,17 @@ func (h *GrpcHandler) GetMovieScheduleByTheaterID(ctx context.Context, req *movi
 }
 
 func (h *GrpcHandler) GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, req *movie_booking.GetMovieScheduleByMovieIdAndTheaterIdRequest) (*movie_booking.GetMovieScheduleByMovieIdAndTheaterIdResponse, error) {
-	movieSchedules, err := h.svc.GetMovieScheduleByMovieIdAndTheaterId(ctx, int(req.MovieId), int(req.TheaterId))
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	movieSchedules, nextPageToken, err := h.svc.GetMovieScheduleByMovieIdAndTheaterId(ctx, int(req.MovieId), int(req.TheaterId), page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var scheduleList []*movie_booking.MovieSchedule
 	for _, schedule := range movieSchedules {
@@ -1019,7 +1070,6 @@ func (h *GrpcHandler) UpdateTheater(ctx context.Context, req *movie_booking.Upda
 		City:            req.City,
 		District:        req.District,
 		State:           req.State,
-		OwnerID:         uint(req.OwnerId),
 		NumberOfScreens: int(req.NumberOfScreens),
 		TheaterTypeID:   int(req.TheaterTypeId),
 	})
@@ -1029,10 +1079,17 @@ func (h *GrpcHandler) UpdateTheater(ctx context.Context, req *movie_booking.Upda
 	return &movie_booking.UpdateTheaterResponse{}, nil
 }
 func (h *GrpcHandler) ListTheaters(ctx context.Context, req *movie_booking.ListTheatersRequest) (*movie_booking.ListTheatersResponse, error) {
-	theatersWithType, err := h.svc.ListTheaters(ctx)
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	theatersWithType, nextPageToken, err := h.svc.ListTheaters(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var grpcTheaters []*movie_booking.Theater
 
@@ -1068,7 +1125,7 @@ func (h *GrpcHandler) AddTheaterScreen(ctx context.Context, req *movie_booking.A
 		ScreenNumber: int(req.TheaterScreen.ScreenNumber),
 		SeatCapacity: int(req.TheaterScreen.SeatCapacity),
 		ScreenTypeID: int(req.TheaterScreen.ScreenTypeID),
-	}, int(req.OwnerId)); err != nil {
+	}); err != nil {
 		return &movie_booking.AddTheaterScreenResponse{}, err
 	}
 	return &movie_booking.AddTheaterScreenResponse{}, nil
@@ -1126,7 +1183,7 @@ func (h *GrpcHandler) UpdateTheaterScreen(ctx context.Context, req *movie_bookin
 		ScreenNumber: int(req.TheaterScreen.ScreenNumber),
 		SeatCapacity: int(req.TheaterScreen.SeatCapacity),
 		ScreenTypeID: int(req.TheaterScreen.ScreenTypeID),
-	}, int(req.OwnerId))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1158,12 +1215,12 @@ func (h *GrpcHandler) ListTheaterScreens(ctx context.Context, req *movie_booking
 
 // Showtime
 func (h *GrpcHandler) AddShowtime(ctx context.Context, req *movie_booking.AddShowtimeRequest) (*movie_booking.AddShowtimeResponse, error) {
-	if err := h.svc.AddShowtime(ctx, Showtime{
+	if _, err := h.svc.AddShowtime(ctx, Showtime{
 		MovieID:  int(req.Showtime.MovieId),
 		ScreenID: int(req.Showtime.ScreenId),
 		ShowDate: req.Showtime.ShowDate.AsTime(),
 		ShowTime: req.Showtime.ShowTime.AsTime(),
-	}, int(req.OwnerId)); err != nil {
+	}); err != nil {
 		return &movie_booking.AddShowtimeResponse{}, err
 	}
 	return &movie_booking.AddShowtimeResponse{}, nil
@@ -1221,7 +1278,7 @@ func (h *GrpcHandler) UpdateShowtime(ctx context.Context, req *movie_booking.Upd
 		ScreenID: int(req.Showtime.ScreenId),
 		ShowDate: req.Showtime.ShowDate.AsTime(),
 		ShowTime: req.Showtime.ShowTime.AsTime(),
-	}, int(req.OwnerId))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1229,10 +1286,17 @@ func (h *GrpcHandler) UpdateShowtime(ctx context.Context, req *movie_booking.Upd
 }
 
 func (h *GrpcHandler) ListShowtimes(ctx context.Context, req *movie_booking.ListShowtimesRequest) (*movie_booking.ListShowtimesResponse, error) {
-	response, err := h.svc.ListShowtimes(ctx, int(req.MovieId))
+	page, err := pagination.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, nextPageToken, err := h.svc.ListShowtimes(ctx, int(req.MovieId), page)
 	if err != nil {
 		return nil, err
 	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 
 	var grpcShowtimes []*movie_booking.Showtime
 	for _, m := range response {
@@ -1252,10 +1316,17 @@ func (h *GrpcHandler) ListShowtimes(ctx context.Context, req *movie_booking.List
 }
 
 func (h *GrpcHandler) ListShowtimesByShowDateAndMovieID(ctx context.Context, req *movie_booking.ListShowtimesByShowDateAndMovieIdRequest) (*movie_booking.ListShowtimesByShowDateAndMovieIdResponse, error) {
-	showtimes, err := h.svc.ListShowtimesByShowDateAndMovieID(ctx, req.ShowDate.AsTime(), int(req.MovieId))
+	page, err := pagination.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	showtimes, nextPageToken, err := h.svc.ListShowtimesByShowDateAndMovieID(ctx, req.ShowDate.AsTime(), int(req.MovieId), page)
+	if err != nil {
+		return nil, err
+	}
+	if err := pagination.SetNextPageToken(ctx, nextPageToken); err != nil {
+		return nil, err
+	}
 	response := []*movie_booking.Showtime{}
 	for _, showtime := range showtimes {
 		res := movie_booking.Showtime{
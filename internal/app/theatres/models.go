@@ -29,7 +29,6 @@ type TheaterUpdateInput struct {
 	City            string `json:"city,omitempty"`
 	District        string `json:"district,omitempty"`
 	State           string `json:"state,omitempty"`
-	OwnerID         uint   `json:"owner_id,omitempty"`
 	NumberOfScreens int    `json:"number_of_screens,omitempty"`
 	TheaterTypeID   int    `json:"theater_type_id,omitempty"`
 }
@@ -44,8 +43,16 @@ type TheaterType struct {
 // Screen Type
 type ScreenType struct {
 	gorm.Model
-	ScreenTypeName string          `json:"screen_type_name"`
-	TheaterScreens []TheaterScreen `gorm:"foreignKey:ScreenTypeID"`
+	ScreenTypeName string             `json:"screen_type_name"`
+	TheaterScreens []TheaterScreen    `gorm:"foreignKey:ScreenTypeID"`
+	Formats        []ScreenTypeFormat `gorm:"foreignKey:ScreenTypeID"`
+}
+
+// ScreenTypeFormat is a projection format a screen type supports. Screen
+// types without any only show 2D.
+type ScreenTypeFormat struct {
+	ScreenTypeID uint   `gorm:"primaryKey;autoIncrement:false"`
+	Format       string `gorm:"primaryKey;type:varchar(10)"`
 }
 
 // Theater Screen
@@ -64,12 +71,13 @@ type TheaterScreen struct {
 // Showtime
 type Showtime struct {
 	gorm.Model
-	MovieID       int           `json:"movie_id"`
-	ScreenID      int           `json:"screen_id"`
-	ShowDate      time.Time     `json:"show_date"`
-	ShowTime      time.Time     `json:"show_time"`
-	Movie         Movie         `gorm:"foreignKey:MovieID"`
-	TheaterScreen TheaterScreen `gorm:"foreignKey:ScreenID"`
+	MovieID        int           `json:"movie_id"`
+	MovieVersionID int           `json:"movie_version_id"`
+	ScreenID       int           `json:"screen_id"`
+	ShowDate       time.Time     `json:"show_date"`
+	ShowTime       time.Time     `json:"show_time"`
+	Movie          Movie         `gorm:"foreignKey:MovieID"`
+	TheaterScreen  TheaterScreen `gorm:"foreignKey:ScreenID"`
 }
 
 // Movie Schedule
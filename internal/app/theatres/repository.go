@@ -2,9 +2,10 @@ package theatres
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
 	"gorm.io/gorm"
 )
 
@@ -12,6 +13,40 @@ type repository struct {
 	db *gorm.DB
 }
 
+var theaterListSpec = pagination.Spec[Theater]{
+	Fields: map[string]pagination.Field[Theater]{
+		"name":            {Column: "COALESCE(name, '')", Type: pagination.String, Value: func(t Theater) interface{} { return t.Name }},
+		"place":           {Column: "COALESCE(place, '')", Type: pagination.String, Value: func(t Theater) interface{} { return t.Place }},
+		"city":            {Column: "COALESCE(city, '')", Type: pagination.String, Value: func(t Theater) interface{} { return t.City }},
+		"district":        {Column: "COALESCE(district, '')", Type: pagination.String, Value: func(t Theater) interface{} { return t.District }},
+		"state":           {Column: "COALESCE(state, '')", Type: pagination.String, Value: func(t Theater) interface{} { return t.State }},
+		"owner_id":        {Column: "owner_id", Type: pagination.Int},
+		"theater_type_id": {Column: "theater_type_id", Type: pagination.Int},
+		"created_at":      {Column: "created_at", Type: pagination.Time, Value: func(t Theater) interface{} { return t.CreatedAt }},
+	},
+	DefaultOrder: "name",
+	ID:           pagination.Field[Theater]{Column: "id", Type: pagination.Int, Value: func(t Theater) interface{} { return t.ID }},
+}
+
+var showtimeListSpec = pagination.Spec[Showtime]{
+	Fields: map[string]pagination.Field[Showtime]{
+		"show_date": {Column: "show_date", Type: pagination.Time, Value: func(s Showtime) interface{} { return s.ShowDate }},
+		"show_time": {Column: "show_time", Type: pagination.Time, Value: func(s Showtime) interface{} { return s.ShowTime }},
+		"screen_id": {Column: "screen_id", Type: pagination.Int, Value: func(s Showtime) interface{} { return s.ScreenID }},
+	},
+	DefaultOrder: "show_time",
+	ID:           pagination.Field[Showtime]{Column: "id", Type: pagination.Int, Value: func(s Showtime) interface{} { return s.ID }},
+}
+
+var movieScheduleListSpec = pagination.Spec[MovieSchedule]{
+	Fields: map[string]pagination.Field[MovieSchedule]{
+		"movie_id":    {Column: "movie_id", Type: pagination.Int, Value: func(m MovieSchedule) interface{} { return m.MovieID }},
+		"theater_id":  {Column: "theater_id", Type: pagination.Int, Value: func(m MovieSchedule) interface{} { return m.TheaterID }},
+		"showtime_id": {Column: "showtime_id", Type: pagination.Int, Value: func(m MovieSchedule) interface{} { return m.ShowtimeID }},
+	},
+	ID: pagination.Field[MovieSchedule]{Column: "id", Type: pagination.Int, Value: func(m MovieSchedule) interface{} { return m.ID }},
+}
+
 type Repository interface {
 	//theater type
 	CreateTheaterType(ctx context.Context, theaterType TheaterType) error
@@ -31,6 +66,8 @@ type Repository interface {
 	GetScreenTypeByName(ctx context.Context, name string) (*ScreenType, error)
 	UpdateScreenType(ctx context.Context, id int, screenType ScreenType) error
 	ListScreenTypes(ctx context.Context) ([]ScreenType, error)
+	GetScreenTypeFormats(ctx context.Context, screenTypeId int) ([]string, error)
+	SetScreenTypeFormats(ctx context.Context, screenTypeId int, formats []string) error
 	//seat category
 	CreateSeatCategory(ctx context.Context, seatCategory SeatCategory) error
 	DeleteSeatCategoryByID(ctx context.Context, id int) error
@@ -53,7 +90,7 @@ type Repository interface {
 	GetTheaterByName(ctx context.Context, name string) ([]Theater, error)
 	UpdateTheater(ctx context.Context, id int, theater Theater) error
 	UpdateTheaterWithoutID(ctx context.Context, theater *Theater) error
-	ListTheaters(ctx context.Context) ([]Theater, error)
+	ListTheaters(ctx context.Context, page pagination.Request) (*pagination.Page[Theater], error)
 	FindActiveTheaterByNamePlaceAndCity(ctx context.Context, name string, place string, city string) (*Theater, error)
 	//Theater screen
 	CreateTheaterScreen(ctx context.Context, theaterScreen TheaterScreen) error
@@ -64,33 +101,34 @@ type Repository interface {
 	GetTheaterScreenByNumber(ctx context.Context, theaterID int, screenNumber int) (*TheaterScreen, error)
 	UpdateTheaterScreen(ctx context.Context, id int, theaterScreen TheaterScreen) error
 	ListTheaterScreens(ctx context.Context, theaterId int) ([]TheaterScreen, error)
-	GetTheatersByCity(ctx context.Context, city string) ([]Theater, error)
+	GetTheatersByCity(ctx context.Context, city string, page pagination.Request) (*pagination.Page[Theater], error)
 	GetTheatersAndMovieScheduleByMovieName(ctx context.Context, id int) ([]MovieSchedule, error)
 	GetTheaterScreenByTheaterID(ctx context.Context, theaterId int) ([]TheaterScreen, error)
 	//Show Time
 	FindShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) (*Showtime, error)
-	CreateShowtime(ctx context.Context, showtime Showtime) error
+	CreateShowtime(ctx context.Context, showtime Showtime) (int, error)
 	DeleteShowtimeByID(ctx context.Context, id int) error
 	DeleteShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) error
 	GetShowtimeByID(ctx context.Context, id int) (*Showtime, error)
 	GetShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) (*Showtime, error)
-	ListShowtimes(ctx context.Context, movieID int) ([]Showtime, error)
+	ListShowtimes(ctx context.Context, movieID int, page pagination.Request) (*pagination.Page[Showtime], error)
 	UpdateShowtime(ctx context.Context, id int, showtime Showtime) error
-	ListShowTimeByTheaterID(ctx context.Context, screenIDs []int) ([]Showtime, error)
-	ListShowTimeByTheaterIDandMovieID(ctx context.Context, screenIDs []int, movieId int) ([]Showtime, error)
-	ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int) ([]Showtime, error)
+	ListShowTimeByTheaterID(ctx context.Context, screenIDs []int, page pagination.Request) (*pagination.Page[Showtime], error)
+	ListShowTimeByTheaterIDandMovieID(ctx context.Context, screenIDs []int, movieId int, page pagination.Request) (*pagination.Page[Showtime], error)
+	ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int, page pagination.Request) (*pagination.Page[Showtime], error)
 	// Movie Shedule
 	GetMovieScheduleByDetails(ctx context.Context, movieId, theaterId, showtimeId int) (*MovieSchedule, error)
 	CreateMovieSchedule(ctx context.Context, movieSchedule MovieSchedule) error
 	DeleteMovieScheduleById(ctx context.Context, id int) error
 	DeleteMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId, theaterId int) error
 	DeleteMovieScheduleByMovieIdAndTheaterIdAndShowTimeId(ctx context.Context, movieId, theaterId, showTimeId int) error
-	GetAllMovieSchedules(ctx context.Context) ([]MovieSchedule, error)
+	GetAllMovieSchedules(ctx context.Context, page pagination.Request) (*pagination.Page[MovieSchedule], error)
 	GetMovieScheduleByID(ctx context.Context, id int) (*MovieSchedule, error)
-	GetMovieScheduleByMovieID(ctx context.Context, movieId int) ([]MovieSchedule, error)
+	GetMovieScheduleByMovieID(ctx context.Context, movieId int, page pagination.Request) (*pagination.Page[MovieSchedule], error)
 	GetMovieScheduleByMovieIdAndShowTimeId(ctx context.Context, movieId, showTimeId int) ([]MovieSchedule, error)
-	GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId, theaterId int) ([]MovieSchedule, error)
+	GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId, theaterId int, page pagination.Request) (*pagination.Page[MovieSchedule], error)
 	GetMovieScheduleByTheaterID(ctx context.Context, theaterId int) ([]MovieSchedule, error)
+	ListMovieSchedulesByTheaterID(ctx context.Context, theaterId int, page pagination.Request) (*pagination.Page[MovieSchedule], error)
 	GetMovieScheduleByTheaterIdAndShowTimeId(ctx context.Context, theaterId, showTimeId int) ([]MovieSchedule, error)
 	UpdateMovieScheduleWithoutID(ctx context.Context, movieschedule *MovieSchedule) error
 	// Seats
@@ -114,56 +152,44 @@ func NewRepository(db *gorm.DB) Repository {
 }
 
 // Show Time
-func (r *repository) ListShowTimeByTheaterIDandMovieID(ctx context.Context, screenIDs []int, movieId int) ([]Showtime, error) {
-	var showtimes []Showtime
-	if err := r.db.Preload("Movie").Preload("TheaterScreen").
-		Where("movie_id = ? AND screen_id IN ?", movieId, screenIDs).
-		Find(&showtimes).
-		Error; err != nil {
-		return nil, err
-	}
-	return showtimes, nil
+func (r *repository) ListShowTimeByTheaterIDandMovieID(ctx context.Context, screenIDs []int, movieId int, page pagination.Request) (*pagination.Page[Showtime], error) {
+	query := r.db.WithContext(ctx).Preload("Movie").Preload("TheaterScreen").Where("movie_id = ? AND screen_id IN ?", movieId, screenIDs)
+	return pagination.List(query, showtimeListSpec, page)
 }
 
-func (r *repository) ListShowTimeByTheaterID(ctx context.Context, screenIDs []int) ([]Showtime, error) {
-	var showtimes []Showtime
-	if err := r.db.Preload("Movie").Preload("TheaterScreen").Where("screen_id IN ?", screenIDs).
-		Find(&showtimes).
-		Error; err != nil {
-		return nil, err
-	}
-
-	return showtimes, nil
+func (r *repository) ListShowTimeByTheaterID(ctx context.Context, screenIDs []int, page pagination.Request) (*pagination.Page[Showtime], error) {
+	query := r.db.WithContext(ctx).Preload("Movie").Preload("TheaterScreen").Where("screen_id IN ?", screenIDs)
+	return pagination.List(query, showtimeListSpec, page)
 }
 
 // Seats
 func (r *repository) DeleteSeatById(ctx context.Context, id int) error {
 	seat := Seat{}
-	result := r.db.Where("id =?", id).Delete(&seat)
+	result := r.db.WithContext(ctx).Where("id =?", id).Delete(&seat)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no seat found with id %d", id)
+		return apperrors.NotFound("no seat found with id %d", id)
 	}
 	return nil
 }
 
 func (r *repository) DeleteSeatBySeatNumberAndScreenId(ctx context.Context, screenId int, seatNumber string) error {
 	seat := Seat{}
-	result := r.db.Where("screen_id =? AND seat_number=?", screenId, seatNumber).Delete(&seat)
+	result := r.db.WithContext(ctx).Where("screen_id =? AND seat_number=?", screenId, seatNumber).Delete(&seat)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no seat found with screenid %d and seatnumber %s", screenId, seatNumber)
+		return apperrors.NotFound("no seat found with screenid %d and seatnumber %s", screenId, seatNumber)
 	}
 	return nil
 }
 
 func (r *repository) GetSeatById(ctx context.Context, id int) (*Seat, error) {
 	seat := Seat{}
-	if err := r.db.Preload("TheaterScreen").Preload("SeatCategory").Where("id = ?", id).First(&seat).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("TheaterScreen").Preload("SeatCategory").Where("id = ?", id).First(&seat).Error; err != nil {
 		return nil, err
 	}
 	return &seat, nil
@@ -171,7 +197,7 @@ func (r *repository) GetSeatById(ctx context.Context, id int) (*Seat, error) {
 
 func (r *repository) GetSeatBySeatNumberAndScreenID(ctx context.Context, seatNumber string, screenId int) (*Seat, error) {
 	var seat Seat
-	if err := r.db.Unscoped().Where("seat_number = ? AND screen_id = ?", seatNumber, screenId).First(&seat).Error; err != nil {
+	if err := r.db.WithContext(ctx).Unscoped().Where("seat_number = ? AND screen_id = ?", seatNumber, screenId).First(&seat).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, gorm.ErrRecordNotFound
 		}
@@ -181,21 +207,21 @@ func (r *repository) GetSeatBySeatNumberAndScreenID(ctx context.Context, seatNum
 }
 
 func (r *repository) UpdateSeatWithoutID(ctx context.Context, seat *Seat) error {
-	if err := r.db.Save(seat).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(seat).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (r *repository) CreateSeat(ctx context.Context, seat Seat) error {
-	if err := r.db.Create(&seat).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&seat).Error; err != nil {
 		return err
 	}
 	return nil
 }
 func (r *repository) GetSeatsByScreenId(ctx context.Context, screenId int) ([]Seat, error) {
 	seats := []Seat{}
-	result := r.db.Preload("TheaterScreen").Preload("SeatCategory").Where("screen_id =? ", screenId).Find(&seats)
+	result := r.db.WithContext(ctx).Preload("TheaterScreen").Preload("SeatCategory").Where("screen_id =? ", screenId).Find(&seats)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -204,7 +230,7 @@ func (r *repository) GetSeatsByScreenId(ctx context.Context, screenId int) ([]Se
 
 func (r *repository) GetSeatBySeatNumberAndScreenId(ctx context.Context, screenId int, seatNumber string) (*Seat, error) {
 	seat := Seat{}
-	if err := r.db.Where("screen_id = ? AND seat_number = ?", screenId, seatNumber).First(&seat).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("screen_id = ? AND seat_number = ?", screenId, seatNumber).First(&seat).Error; err != nil {
 		return nil, err
 	}
 	return &seat, nil
@@ -213,21 +239,21 @@ func (r *repository) GetSeatBySeatNumberAndScreenId(ctx context.Context, screenI
 // Movie Schedule
 func (r *repository) GetTheatersAndMovieScheduleByMovieName(ctx context.Context, id int) ([]MovieSchedule, error) {
 	movieSchedule := []MovieSchedule{}
-	if err := r.db.Preload("Theater").Preload("Showtime").Where("movie_id =?", id).Find(&movieSchedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Theater").Preload("Showtime").Where("movie_id =?", id).Find(&movieSchedule).Error; err != nil {
 		return nil, err
 	}
 	return movieSchedule, nil
 }
 
 func (r *repository) UpdateMovieScheduleWithoutID(ctx context.Context, movieschedule *MovieSchedule) error {
-	if err := r.db.Save(movieschedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(movieschedule).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (r *repository) CreateMovieSchedule(ctx context.Context, movieSchedule MovieSchedule) error {
-	if err := r.db.Create(&movieSchedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&movieSchedule).Error; err != nil {
 		return err
 	}
 	return nil
@@ -235,52 +261,48 @@ func (r *repository) CreateMovieSchedule(ctx context.Context, movieSchedule Movi
 
 func (r *repository) DeleteMovieScheduleById(ctx context.Context, id int) error {
 	movieSchedule := &MovieSchedule{}
-	result := r.db.Where("id =?", id).Delete(&movieSchedule)
+	result := r.db.WithContext(ctx).Where("id =?", id).Delete(&movieSchedule)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no movieschedule with id %d", id)
+		return apperrors.NotFound("no movieschedule with id %d", id)
 	}
 	return nil
 }
 
 func (r *repository) DeleteMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId int, theaterId int) error {
 	movieSchedule := &MovieSchedule{}
-	result := r.db.Where("movie_id =? AND theater_id = ?", movieId, theaterId).Delete(&movieSchedule)
+	result := r.db.WithContext(ctx).Where("movie_id =? AND theater_id = ?", movieId, theaterId).Delete(&movieSchedule)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no movieschedule with movieid %d and theaterId %d", movieId, theaterId)
+		return apperrors.NotFound("no movieschedule with movieid %d and theaterId %d", movieId, theaterId)
 	}
 	return nil
 }
 
 func (r *repository) DeleteMovieScheduleByMovieIdAndTheaterIdAndShowTimeId(ctx context.Context, movieId int, theaterId int, showTimeId int) error {
 	movieSchedule := &MovieSchedule{}
-	result := r.db.Where("movie_id =? AND theater_id = ? AND showtime_id =?", movieId, theaterId, showTimeId).Delete(&movieSchedule)
+	result := r.db.WithContext(ctx).Where("movie_id =? AND theater_id = ? AND showtime_id =?", movieId, theaterId, showTimeId).Delete(&movieSchedule)
 
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no movieschedule with movieid %d and theaterId %d and showtime id %d", movieId, theaterId, showTimeId)
+		return apperrors.NotFound("no movieschedule with movieid %d and theaterId %d and showtime id %d", movieId, theaterId, showTimeId)
 	}
 	return nil
 }
 
-func (r *repository) GetAllMovieSchedules(ctx context.Context) ([]MovieSchedule, error) {
-	movieSchedules := []MovieSchedule{}
-	if err := r.db.Find(&movieSchedules).Error; err != nil {
-		return nil, err
-	}
-	return movieSchedules, nil
+func (r *repository) GetAllMovieSchedules(ctx context.Context, page pagination.Request) (*pagination.Page[MovieSchedule], error) {
+	return pagination.List(r.db.WithContext(ctx), movieScheduleListSpec, page)
 }
 func (r *repository) GetMovieScheduleByDetails(ctx context.Context, movieId int, theaterId int, showtimeId int) (*MovieSchedule, error) {
 	movieSchedule := &MovieSchedule{}
 
-	err := r.db.Unscoped().Where("movie_id = ? AND theater_id = ? AND showtime_id = ?", movieId, theaterId, showtimeId).First(movieSchedule).Error
+	err := r.db.WithContext(ctx).Unscoped().Where("movie_id = ? AND theater_id = ? AND showtime_id = ?", movieId, theaterId, showtimeId).First(movieSchedule).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -293,47 +315,44 @@ func (r *repository) GetMovieScheduleByDetails(ctx context.Context, movieId int,
 
 func (r *repository) GetMovieScheduleByID(ctx context.Context, id int) (*MovieSchedule, error) {
 	movieSchedule := &MovieSchedule{}
-	if err := r.db.Where("id = ?", id).Preload("Theater").First(&movieSchedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Preload("Theater").First(&movieSchedule).Error; err != nil {
 		return nil, err
 	}
 	return movieSchedule, nil
 }
 
-func (r *repository) GetMovieScheduleByMovieID(ctx context.Context, movieId int) ([]MovieSchedule, error) {
-	movieSchedule := []MovieSchedule{}
-	if err := r.db.Where("movie_id = ?", movieId).Find(&movieSchedule).Error; err != nil {
-		return nil, err
-	}
-	return movieSchedule, nil
+func (r *repository) GetMovieScheduleByMovieID(ctx context.Context, movieId int, page pagination.Request) (*pagination.Page[MovieSchedule], error) {
+	return pagination.List(r.db.WithContext(ctx).Where("movie_id = ?", movieId), movieScheduleListSpec, page)
 }
 
 func (r *repository) GetMovieScheduleByMovieIdAndShowTimeId(ctx context.Context, movieId int, showTimeId int) ([]MovieSchedule, error) {
 	movieSchedule := []MovieSchedule{}
-	if err := r.db.Where("movie_id = ? AND showtime_id = ?", movieId, showTimeId).Find(&movieSchedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("movie_id = ? AND showtime_id = ?", movieId, showTimeId).Find(&movieSchedule).Error; err != nil {
 		return nil, err
 	}
 	return movieSchedule, nil
 }
 
-func (r *repository) GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId int, theaterId int) ([]MovieSchedule, error) {
-	movieSchedule := []MovieSchedule{}
-	if err := r.db.Where("movie_id = ? AND theater_id = ?", movieId, theaterId).Find(&movieSchedule).Error; err != nil {
-		return nil, err
-	}
-	return movieSchedule, nil
+func (r *repository) GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId int, theaterId int, page pagination.Request) (*pagination.Page[MovieSchedule], error) {
+	return pagination.List(r.db.WithContext(ctx).Where("movie_id = ? AND theater_id = ?", movieId, theaterId), movieScheduleListSpec, page)
 }
 
 func (r *repository) GetMovieScheduleByTheaterID(ctx context.Context, theaterId int) ([]MovieSchedule, error) {
 	movieSchedule := []MovieSchedule{}
-	if err := r.db.Preload("Theater").Preload("Showtime").Where("theater_id = ?", theaterId).Find(&movieSchedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Theater").Preload("Showtime").Where("theater_id = ?", theaterId).Find(&movieSchedule).Error; err != nil {
 		return nil, err
 	}
 	return movieSchedule, nil
 }
 
+func (r *repository) ListMovieSchedulesByTheaterID(ctx context.Context, theaterId int, page pagination.Request) (*pagination.Page[MovieSchedule], error) {
+	query := r.db.WithContext(ctx).Preload("Theater").Preload("Showtime").Where("theater_id = ?", theaterId)
+	return pagination.List(query, movieScheduleListSpec, page)
+}
+
 func (r *repository) GetMovieScheduleByTheaterIdAndShowTimeId(ctx context.Context, theaterId int, showTimeId int) ([]MovieSchedule, error) {
 	movieSchedule := []MovieSchedule{}
-	if err := r.db.Where("theater_id = ? AND showtime_id = ?", theaterId, showTimeId).Find(&movieSchedule).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("theater_id = ? AND showtime_id = ?", theaterId, showTimeId).Find(&movieSchedule).Error; err != nil {
 		return nil, err
 	}
 	return movieSchedule, nil
@@ -342,7 +361,7 @@ func (r *repository) GetMovieScheduleByTheaterIdAndShowTimeId(ctx context.Contex
 // Theater type
 func (r *repository) FindTheatertypeByName(ctx context.Context, name string) (*TheaterType, error) {
 	theaterType := &TheaterType{}
-	res := r.db.Where("theater_type_name ILIKE ?", name).First(&theaterType)
+	res := r.db.WithContext(ctx).Where("theater_type_name ILIKE ?", name).First(&theaterType)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound {
 			return nil, gorm.ErrRecordNotFound
@@ -356,7 +375,7 @@ func (r *repository) FindTheatertypeByName(ctx context.Context, name string) (*T
 }
 
 func (r *repository) CreateTheaterType(ctx context.Context, theaterType TheaterType) error {
-	if err := r.db.Create(&theaterType).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&theaterType).Error; err != nil {
 		return err
 	}
 	return nil
@@ -364,31 +383,31 @@ func (r *repository) CreateTheaterType(ctx context.Context, theaterType TheaterT
 
 func (r *repository) DeleteTheaterTypeByID(ctx context.Context, id int) error {
 	theaterType := &TheaterType{}
-	result := r.db.Where("id=?", id).Delete(&theaterType)
+	result := r.db.WithContext(ctx).Where("id=?", id).Delete(&theaterType)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no theater type found with ID %d", id)
+		return apperrors.NotFound("no theater type found with ID %d", id)
 	}
 	return nil
 }
 
 func (r *repository) DeleteTheaterTypeByName(ctx context.Context, name string) error {
 	theaterType := &TheaterType{}
-	result := r.db.Where("theater_type_name ILIKE ?", name).Delete(&theaterType)
+	result := r.db.WithContext(ctx).Where("theater_type_name ILIKE ?", name).Delete(&theaterType)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no theater type found with name %s", name)
+		return apperrors.NotFound("no theater type found with name %s", name)
 	}
 	return nil
 }
 
 func (r *repository) GetTheaterTypeByID(ctx context.Context, id int) (*TheaterType, error) {
 	theatertype := TheaterType{}
-	if err := r.db.Where("id =?", id).First(&theatertype).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id =?", id).First(&theatertype).Error; err != nil {
 		return nil, err
 	}
 	return &theatertype, nil
@@ -396,7 +415,7 @@ func (r *repository) GetTheaterTypeByID(ctx context.Context, id int) (*TheaterTy
 
 func (r *repository) GetTheaterTypeByName(ctx context.Context, name string) (*TheaterType, error) {
 	theaterType := &TheaterType{}
-	if err := r.db.Where("theater_type_name ILIKE ?", name).First(&theaterType).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("theater_type_name ILIKE ?", name).First(&theaterType).Error; err != nil {
 		return nil, err
 	}
 	return theaterType, nil
@@ -404,16 +423,16 @@ func (r *repository) GetTheaterTypeByName(ctx context.Context, name string) (*Th
 
 func (r *repository) ListTheaterTypes(ctx context.Context) ([]TheaterType, error) {
 	theaterTypes := []TheaterType{}
-	if err := r.db.Find(&theaterTypes).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&theaterTypes).Error; err != nil {
 		return nil, err
 	}
 	return theaterTypes, nil
 }
 
 func (r *repository) UpdateTheaterType(ctx context.Context, id int, theaterType TheaterType) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
+	r.db.WithContext(ctx).Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
 
-	result := r.db.Model(&TheaterType{}).Where("id = ?", id).Updates(theaterType)
+	result := r.db.WithContext(ctx).Model(&TheaterType{}).Where("id = ?", id).Updates(theaterType)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -426,7 +445,7 @@ func (r *repository) UpdateTheaterType(ctx context.Context, id int, theaterType
 // screen types
 func (r *repository) FindScreenTypeByName(ctx context.Context, name string) (*ScreenType, error) {
 	screenType := &ScreenType{}
-	res := r.db.Where("screen_type_name ILIKE ?", name).First(&screenType)
+	res := r.db.WithContext(ctx).Where("screen_type_name ILIKE ?", name).First(&screenType)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound {
 			return nil, gorm.ErrRecordNotFound
@@ -439,7 +458,7 @@ func (r *repository) FindScreenTypeByName(ctx context.Context, name string) (*Sc
 }
 
 func (r *repository) CreateScreenType(ctx context.Context, screenType ScreenType) error {
-	if err := r.db.Create(&screenType).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&screenType).Error; err != nil {
 		return err
 	}
 	return nil
@@ -447,31 +466,31 @@ func (r *repository) CreateScreenType(ctx context.Context, screenType ScreenType
 
 func (r *repository) DeleteScreenTypeByID(ctx context.Context, id int) error {
 	screenType := &ScreenType{}
-	result := r.db.Where("id = ?", id).Delete(&screenType)
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&screenType)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no screen type with id %d", id)
+		return apperrors.NotFound("no screen type with id %d", id)
 	}
 	return nil
 }
 
 func (r *repository) DeleteScreenTypeByName(ctx context.Context, name string) error {
 	screenType := &ScreenType{}
-	result := r.db.Where("screen_type_name ILIKE ?", name).Delete(&screenType)
+	result := r.db.WithContext(ctx).Where("screen_type_name ILIKE ?", name).Delete(&screenType)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no screen type with name  %s", name)
+		return apperrors.NotFound("no screen type with name  %s", name)
 	}
 	return nil
 }
 
 func (r *repository) GetScreenTypeByID(ctx context.Context, id int) (*ScreenType, error) {
 	screenType := ScreenType{}
-	if err := r.db.Where("id = ?", id).First(&screenType).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&screenType).Error; err != nil {
 		return nil, err
 	}
 	return &screenType, nil
@@ -479,7 +498,7 @@ func (r *repository) GetScreenTypeByID(ctx context.Context, id int) (*ScreenType
 
 func (r *repository) GetScreenTypeByName(ctx context.Context, name string) (*ScreenType, error) {
 	screenType := &ScreenType{}
-	if err := r.db.Where("screen_type_name ILIKE ?", name).First(&screenType).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("screen_type_name ILIKE ?", name).First(&screenType).Error; err != nil {
 		return nil, err
 	}
 	return screenType, nil
@@ -487,20 +506,20 @@ func (r *repository) GetScreenTypeByName(ctx context.Context, name string) (*Scr
 
 func (r *repository) ListScreenTypes(ctx context.Context) ([]ScreenType, error) {
 	screenTypes := []ScreenType{}
-	if err := r.db.Find(&screenTypes).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&screenTypes).Error; err != nil {
 		return nil, err
 	}
 	return screenTypes, nil
 }
 
 func (r *repository) UpdateScreenType(ctx context.Context, id int, screenType ScreenType) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
-	result := r.db.Model(&ScreenType{}).Where("id = ?", id).Updates(screenType)
+	r.db.WithContext(ctx).Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
+	result := r.db.WithContext(ctx).Model(&ScreenType{}).Where("id = ?", id).Updates(screenType)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no screen type with id %d", id)
+		return apperrors.NotFound("no screen type with id %d", id)
 	}
 	return nil
 }
@@ -508,7 +527,7 @@ func (r *repository) UpdateScreenType(ctx context.Context, id int, screenType Sc
 // seat category
 func (r *repository) FindSeatCategoryByName(ctx context.Context, name string) (*SeatCategory, error) {
 	seatCategory := &SeatCategory{}
-	res := r.db.Where("seat_category_name ILIKE ?", name).First(&seatCategory)
+	res := r.db.WithContext(ctx).Where("seat_category_name ILIKE ?", name).First(&seatCategory)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound {
 			return nil, gorm.ErrRecordNotFound
@@ -521,7 +540,7 @@ func (r *repository) FindSeatCategoryByName(ctx context.Context, name string) (*
 }
 
 func (r *repository) CreateSeatCategory(ctx context.Context, seatCategory SeatCategory) error {
-	if err := r.db.Create(&seatCategory).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&seatCategory).Error; err != nil {
 		return err
 	}
 	return nil
@@ -529,31 +548,31 @@ func (r *repository) CreateSeatCategory(ctx context.Context, seatCategory SeatCa
 
 func (r *repository) DeleteSeatCategoryByID(ctx context.Context, id int) error {
 	seatCategory := &SeatCategory{}
-	result := r.db.Where("id = ?", id).Delete(&seatCategory)
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&seatCategory)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no seat category with id %d", id)
+		return apperrors.NotFound("no seat category with id %d", id)
 	}
 	return nil
 }
 
 func (r *repository) DeleteSeatCategoryByName(ctx context.Context, name string) error {
 	seatCategory := &SeatCategory{}
-	result := r.db.Where("seat_category_name ILIKE ?", name).Delete(&seatCategory)
+	result := r.db.WithContext(ctx).Where("seat_category_name ILIKE ?", name).Delete(&seatCategory)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no seat category with name %s", name)
+		return apperrors.NotFound("no seat category with name %s", name)
 	}
 	return nil
 }
 
 func (r *repository) GetSeatCategoryByID(ctx context.Context, id int) (*SeatCategory, error) {
 	seatCategory := SeatCategory{}
-	if err := r.db.Where("id = ?", id).First(&seatCategory).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&seatCategory).Error; err != nil {
 		return nil, err
 	}
 	return &seatCategory, nil
@@ -561,7 +580,7 @@ func (r *repository) GetSeatCategoryByID(ctx context.Context, id int) (*SeatCate
 
 func (r *repository) GetSeatCategoryByName(ctx context.Context, name string) (*SeatCategory, error) {
 	seatCategory := &SeatCategory{}
-	if err := r.db.Where("seat_category_name ILIKE ?", name).First(&seatCategory).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("seat_category_name ILIKE ?", name).First(&seatCategory).Error; err != nil {
 		return nil, err
 	}
 	return seatCategory, nil
@@ -569,20 +588,20 @@ func (r *repository) GetSeatCategoryByName(ctx context.Context, name string) (*S
 
 func (r *repository) ListSeatCategories(ctx context.Context) ([]SeatCategory, error) {
 	seatCategories := []SeatCategory{}
-	if err := r.db.Find(&seatCategories).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&seatCategories).Error; err != nil {
 		return nil, err
 	}
 	return seatCategories, nil
 }
 
 func (r *repository) UpdateSeatCategory(ctx context.Context, id int, seatCategory SeatCategory) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
-	result := r.db.Model(&SeatCategory{}).Where("id = ?", id).Updates(seatCategory)
+	r.db.WithContext(ctx).Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
+	result := r.db.WithContext(ctx).Model(&SeatCategory{}).Where("id = ?", id).Updates(seatCategory)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no seat category with id %d", id)
+		return apperrors.NotFound("no seat category with id %d", id)
 	}
 	return nil
 }
@@ -590,7 +609,7 @@ func (r *repository) UpdateSeatCategory(ctx context.Context, id int, seatCategor
 // Theater
 func (r *repository) FindTheaterByNamePlaceAndCity(ctx context.Context, theaterName string, placeName string, cityName string) (*Theater, error) {
 	theater := &Theater{}
-	res := r.db.Unscoped().Where("name ILIKE ? AND place ILIKE ? AND city ILIKE ?", theaterName, placeName, cityName).First(&theater)
+	res := r.db.WithContext(ctx).Unscoped().Where("name ILIKE ? AND place ILIKE ? AND city ILIKE ?", theaterName, placeName, cityName).First(&theater)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound || res.RowsAffected == 0 {
 			return nil, gorm.ErrRecordNotFound
@@ -601,7 +620,7 @@ func (r *repository) FindTheaterByNamePlaceAndCity(ctx context.Context, theaterN
 }
 func (r *repository) FindActiveTheaterByNamePlaceAndCity(ctx context.Context, name string, place string, city string) (*Theater, error) {
 	theater := &Theater{}
-	res := r.db.Where("name ILIKE ? AND place ILIKE ? AND city ILIKE ?", name, place, city).First(&theater)
+	res := r.db.WithContext(ctx).Where("name ILIKE ? AND place ILIKE ? AND city ILIKE ?", name, place, city).First(&theater)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound || res.RowsAffected == 0 {
 			return nil, gorm.ErrRecordNotFound
@@ -612,7 +631,7 @@ func (r *repository) FindActiveTheaterByNamePlaceAndCity(ctx context.Context, na
 }
 func (r *repository) CountTheatersByOwnerAndState(ctx context.Context, ownerId uint, state string) (int, error) {
 	var count int64
-	err := r.db.Model(&Theater{}).
+	err := r.db.WithContext(ctx).Model(&Theater{}).
 		Where("owner_id = ? AND state ILIKE ?", ownerId, state).
 		Count(&count).Error
 	if err != nil {
@@ -622,7 +641,7 @@ func (r *repository) CountTheatersByOwnerAndState(ctx context.Context, ownerId u
 }
 func (r *repository) CountTheatersByOwnerAndDistrict(ctx context.Context, ownerId uint, district string) (int, error) {
 	var count int64
-	err := r.db.Model(&Theater{}).
+	err := r.db.WithContext(ctx).Model(&Theater{}).
 		Where("owner_id = ? AND district ILIKE ?", ownerId, district).
 		Count(&count).Error
 	if err != nil {
@@ -633,7 +652,7 @@ func (r *repository) CountTheatersByOwnerAndDistrict(ctx context.Context, ownerI
 
 func (r *repository) CountTheatersByOwnerAndCity(ctx context.Context, ownerId uint, city string) (int, error) {
 	var count int64
-	err := r.db.Model(&Theater{}).
+	err := r.db.WithContext(ctx).Model(&Theater{}).
 		Where("owner_id = ? AND city ILIKE ?", ownerId, city).
 		Count(&count).Error
 	if err != nil {
@@ -643,7 +662,7 @@ func (r *repository) CountTheatersByOwnerAndCity(ctx context.Context, ownerId ui
 }
 func (r *repository) CountTheatersByOwnerAndPlace(ctx context.Context, ownerId uint, place string) (int, error) {
 	var count int64
-	err := r.db.Model(&Theater{}).
+	err := r.db.WithContext(ctx).Model(&Theater{}).
 		Where("owner_id = ? AND place ILIKE ?", ownerId, place).
 		Count(&count).Error
 	if err != nil {
@@ -652,7 +671,7 @@ func (r *repository) CountTheatersByOwnerAndPlace(ctx context.Context, ownerId u
 	return int(count), nil
 }
 func (r *repository) CreateTheater(ctx context.Context, theater Theater) error {
-	if err := r.db.Create(&theater).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&theater).Error; err != nil {
 		return err
 	}
 	return nil
@@ -660,7 +679,7 @@ func (r *repository) CreateTheater(ctx context.Context, theater Theater) error {
 
 func (r *repository) DeleteTheaterByID(ctx context.Context, id int) error {
 	theater := &Theater{}
-	result := r.db.Where("id = ?", id).Delete(&theater)
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&theater)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -672,7 +691,7 @@ func (r *repository) DeleteTheaterByID(ctx context.Context, id int) error {
 
 func (r *repository) DeleteTheaterByName(ctx context.Context, name string) error {
 	theater := &Theater{}
-	result := r.db.Where("name ILIKE ?", name).Delete(&theater)
+	result := r.db.WithContext(ctx).Where("name ILIKE ?", name).Delete(&theater)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -684,7 +703,7 @@ func (r *repository) DeleteTheaterByName(ctx context.Context, name string) error
 
 func (r *repository) GetTheaterByID(ctx context.Context, id int) (*Theater, error) {
 	theater := Theater{}
-	if err := r.db.Preload("TheaterType").Where("id = ?", id).First(&theater).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("TheaterType").Where("id = ?", id).First(&theater).Error; err != nil {
 		return nil, err
 	}
 	return &theater, nil
@@ -692,38 +711,30 @@ func (r *repository) GetTheaterByID(ctx context.Context, id int) (*Theater, erro
 
 func (r *repository) GetTheaterByName(ctx context.Context, name string) ([]Theater, error) {
 	theater := []Theater{}
-	if err := r.db.Preload("TheaterType").Where("name ILIKE ?", name).Find(&theater).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("TheaterType").Where("name ILIKE ?", name).Find(&theater).Error; err != nil {
 		return nil, err
 	}
 	return theater, nil
 }
 
-func (r *repository) GetTheatersByCity(ctx context.Context, city string) ([]Theater, error) {
-	theater := []Theater{}
-	if err := r.db.Preload("TheaterType").Where("city ILIKE ?", city).Find(&theater).Error; err != nil {
-		return nil, err
-	}
-	return theater, nil
+func (r *repository) GetTheatersByCity(ctx context.Context, city string, page pagination.Request) (*pagination.Page[Theater], error) {
+	return pagination.List(r.db.WithContext(ctx).Preload("TheaterType").Where("city ILIKE ?", city), theaterListSpec, page)
 }
 
-func (r *repository) ListTheaters(ctx context.Context) ([]Theater, error) {
-	theaters := []Theater{}
-	if err := r.db.Preload("TheaterType").Find(&theaters).Error; err != nil {
-		return nil, err
-	}
-	return theaters, nil
+func (r *repository) ListTheaters(ctx context.Context, page pagination.Request) (*pagination.Page[Theater], error) {
+	return pagination.List(r.db.WithContext(ctx).Preload("TheaterType"), theaterListSpec, page)
 }
 
 func (r *repository) UpdateTheaterWithoutID(ctx context.Context, theater *Theater) error {
-	if err := r.db.Save(theater).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(theater).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (r *repository) UpdateTheater(ctx context.Context, id int, theater Theater) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
-	result := r.db.Model(&Theater{}).Where("id = ?", id).Updates(theater)
+	r.db.WithContext(ctx).Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
+	result := r.db.WithContext(ctx).Model(&Theater{}).Where("id = ?", id).Updates(theater)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -736,7 +747,7 @@ func (r *repository) UpdateTheater(ctx context.Context, id int, theater Theater)
 // TheaterScreen
 func (r *repository) GetTheaterScreenByTheaterID(ctx context.Context, theaterId int) ([]TheaterScreen, error) {
 	theaterScreen := []TheaterScreen{}
-	if err := r.db.Preload("Theater").Preload("ScreenType").Where("theater_id = ?", theaterId).Find(&theaterScreen).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Theater").Preload("ScreenType").Where("theater_id = ?", theaterId).Find(&theaterScreen).Error; err != nil {
 		return nil, err
 	}
 	return theaterScreen, nil
@@ -744,7 +755,7 @@ func (r *repository) GetTheaterScreenByTheaterID(ctx context.Context, theaterId
 
 func (r *repository) FindTheaterScreenByTheaterIDAndScreenNumber(ctx context.Context, theaterID int, screenNumber int) (*TheaterScreen, error) {
 	theaterScreen := &TheaterScreen{}
-	res := r.db.Where("theater_id = ? AND screen_number = ?", theaterID, screenNumber).First(theaterScreen)
+	res := r.db.WithContext(ctx).Where("theater_id = ? AND screen_number = ?", theaterID, screenNumber).First(theaterScreen)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound || res.RowsAffected == 0 {
 			return nil, gorm.ErrRecordNotFound
@@ -755,7 +766,7 @@ func (r *repository) FindTheaterScreenByTheaterIDAndScreenNumber(ctx context.Con
 }
 
 func (r *repository) CreateTheaterScreen(ctx context.Context, theaterScreen TheaterScreen) error {
-	if err := r.db.Create(&theaterScreen).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&theaterScreen).Error; err != nil {
 		return err
 	}
 	return nil
@@ -763,7 +774,7 @@ func (r *repository) CreateTheaterScreen(ctx context.Context, theaterScreen Thea
 
 func (r *repository) DeleteTheaterScreenByID(ctx context.Context, id int) error {
 	theaterScreen := &TheaterScreen{}
-	result := r.db.Where("id = ?", id).Delete(&theaterScreen)
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&theaterScreen)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -775,7 +786,7 @@ func (r *repository) DeleteTheaterScreenByID(ctx context.Context, id int) error
 
 func (r *repository) DeleteTheaterScreenByNumber(ctx context.Context, theaterID int, screenNumber int) error {
 	theaterScreen := &TheaterScreen{}
-	result := r.db.Where("theater_id = ? AND screen_number = ?", theaterID, screenNumber).Delete(&theaterScreen)
+	result := r.db.WithContext(ctx).Where("theater_id = ? AND screen_number = ?", theaterID, screenNumber).Delete(&theaterScreen)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -787,7 +798,7 @@ func (r *repository) DeleteTheaterScreenByNumber(ctx context.Context, theaterID
 
 func (r *repository) GetTheaterScreenByID(ctx context.Context, id int) (*TheaterScreen, error) {
 	theaterScreen := &TheaterScreen{}
-	if err := r.db.Where("id = ?", id).Preload("Theater").First(&theaterScreen).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Preload("Theater").First(&theaterScreen).Error; err != nil {
 		return nil, err
 	}
 	return theaterScreen, nil
@@ -795,7 +806,7 @@ func (r *repository) GetTheaterScreenByID(ctx context.Context, id int) (*Theater
 
 func (r *repository) GetTheaterScreenByNumber(ctx context.Context, theaterID int, screenNumber int) (*TheaterScreen, error) {
 	theaterScreen := &TheaterScreen{}
-	if err := r.db.Where("theater_id = ? AND screen_number = ?", theaterID, screenNumber).First(&theaterScreen).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("theater_id = ? AND screen_number = ?", theaterID, screenNumber).First(&theaterScreen).Error; err != nil {
 		return nil, err
 	}
 	return theaterScreen, nil
@@ -803,15 +814,15 @@ func (r *repository) GetTheaterScreenByNumber(ctx context.Context, theaterID int
 
 func (r *repository) ListTheaterScreens(ctx context.Context, theaterId int) ([]TheaterScreen, error) {
 	theaterScreens := []TheaterScreen{}
-	if err := r.db.Where("theater_id =?", theaterId).Find(&theaterScreens).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("theater_id =?", theaterId).Find(&theaterScreens).Error; err != nil {
 		return nil, err
 	}
 	return theaterScreens, nil
 }
 
 func (r *repository) UpdateTheaterScreen(ctx context.Context, id int, theaterScreen TheaterScreen) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
-	result := r.db.Model(&TheaterScreen{}).Where("id = ?", id).Updates(theaterScreen)
+	r.db.WithContext(ctx).Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
+	result := r.db.WithContext(ctx).Model(&TheaterScreen{}).Where("id = ?", id).Updates(theaterScreen)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -824,7 +835,7 @@ func (r *repository) UpdateTheaterScreen(ctx context.Context, id int, theaterScr
 // Show time
 func (r *repository) FindShowtimeByMovieIDAndScreenID(ctx context.Context, movieID int, screenID int) (*Showtime, error) {
 	showtime := &Showtime{}
-	res := r.db.Where("movie_id = ? AND screen_id = ?", movieID, screenID).First(showtime)
+	res := r.db.WithContext(ctx).Where("movie_id = ? AND screen_id = ?", movieID, screenID).First(showtime)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound || res.RowsAffected == 0 {
 			return nil, gorm.ErrRecordNotFound
@@ -834,16 +845,16 @@ func (r *repository) FindShowtimeByMovieIDAndScreenID(ctx context.Context, movie
 	return showtime, nil
 }
 
-func (r *repository) CreateShowtime(ctx context.Context, showtime Showtime) error {
-	if err := r.db.Create(&showtime).Error; err != nil {
-		return err
+func (r *repository) CreateShowtime(ctx context.Context, showtime Showtime) (int, error) {
+	if err := r.db.WithContext(ctx).Create(&showtime).Error; err != nil {
+		return 0, err
 	}
-	return nil
+	return int(showtime.ID), nil
 }
 
 func (r *repository) DeleteShowtimeByID(ctx context.Context, id int) error {
 	showtime := &Showtime{}
-	result := r.db.Where("id = ?", id).Delete(&showtime)
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&showtime)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -855,7 +866,7 @@ func (r *repository) DeleteShowtimeByID(ctx context.Context, id int) error {
 
 func (r *repository) DeleteShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) error {
 	showtime := &Showtime{}
-	result := r.db.Where("movie_id = ? AND screen_id = ? AND show_date = ? AND show_time = ?", movieID, screenID, showDate, showTime).Delete(&showtime)
+	result := r.db.WithContext(ctx).Where("movie_id = ? AND screen_id = ? AND show_date = ? AND show_time = ?", movieID, screenID, showDate, showTime).Delete(&showtime)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -867,7 +878,7 @@ func (r *repository) DeleteShowtimeByDetails(ctx context.Context, movieID int, s
 
 func (r *repository) GetShowtimeByID(ctx context.Context, id int) (*Showtime, error) {
 	showtime := &Showtime{}
-	if err := r.db.Preload("TheaterScreen").Where("id = ?", id).Preload("TheaterScreen").First(&showtime).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("TheaterScreen").Where("id = ?", id).Preload("TheaterScreen").First(&showtime).Error; err != nil {
 		return nil, err
 	}
 	return showtime, nil
@@ -875,23 +886,19 @@ func (r *repository) GetShowtimeByID(ctx context.Context, id int) (*Showtime, er
 
 func (r *repository) GetShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) (*Showtime, error) {
 	showtime := &Showtime{}
-	if err := r.db.Where("movie_id = ? AND screen_id = ? AND show_date = ? AND show_time = ?", movieID, screenID, showDate, showTime).First(&showtime).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("movie_id = ? AND screen_id = ? AND show_date = ? AND show_time = ?", movieID, screenID, showDate, showTime).First(&showtime).Error; err != nil {
 		return nil, err
 	}
 	return showtime, nil
 }
 
-func (r *repository) ListShowtimes(ctx context.Context, movieID int) ([]Showtime, error) {
-	showtimes := []Showtime{}
-	if err := r.db.Where("movie_id = ?", movieID).Find(&showtimes).Error; err != nil {
-		return nil, err
-	}
-	return showtimes, nil
+func (r *repository) ListShowtimes(ctx context.Context, movieID int, page pagination.Request) (*pagination.Page[Showtime], error) {
+	return pagination.List(r.db.WithContext(ctx).Where("movie_id = ?", movieID), showtimeListSpec, page)
 }
 
 func (r *repository) UpdateShowtime(ctx context.Context, id int, showtime Showtime) error {
-	r.db.Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
-	result := r.db.Model(&Showtime{}).Where("id = ?", id).Updates(showtime)
+	r.db.WithContext(ctx).Set("gorm:association_autoupdate", false).Set("gorm:association_autocreate", false)
+	result := r.db.WithContext(ctx).Model(&Showtime{}).Where("id = ?", id).Updates(showtime)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -903,7 +910,7 @@ func (r *repository) UpdateShowtime(ctx context.Context, id int, showtime Showti
 
 func (r *repository) FindShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) (*Showtime, error) {
 	showtime := &Showtime{}
-	res := r.db.Where("movie_id = ? AND screen_id = ? AND show_date = ? AND show_time = ?", movieID, screenID, showDate, showTime).First(showtime)
+	res := r.db.WithContext(ctx).Where("movie_id = ? AND screen_id = ? AND show_date = ? AND show_time = ?", movieID, screenID, showDate, showTime).First(showtime)
 	if res.Error != nil {
 		if res.Error == gorm.ErrRecordNotFound || res.RowsAffected == 0 {
 			return nil, gorm.ErrRecordNotFound
@@ -913,21 +920,16 @@ func (r *repository) FindShowtimeByDetails(ctx context.Context, movieID int, scr
 	return showtime, nil
 }
 
-func (r *repository) ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int) ([]Showtime, error) {
-	showtime := []Showtime{}
-	res := r.db.Preload("Movie").Preload("TheaterScreen").Where("movie_id = ? AND show_date = ?", movieId, showDate).Find(&showtime)
-	if res.Error != nil {
-		if res.Error == gorm.ErrRecordNotFound || res.RowsAffected == 0 {
-			return nil, gorm.ErrRecordNotFound
-		}
-		return nil, res.Error
-	}
-	return showtime, nil
+// ListShowtimesByShowDateAndMovieID loads each showtime with its movie,
+// screen and theater. Preloads take one query per level, not per showtime.
+func (r *repository) ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int, page pagination.Request) (*pagination.Page[Showtime], error) {
+	query := r.db.WithContext(ctx).Preload("Movie").Preload("TheaterScreen.Theater.TheaterType").Where("movie_id = ? AND show_date = ?", movieId, showDate)
+	return pagination.List(query, showtimeListSpec, page)
 }
 
 func (r *repository) GetSeatsByIds(ctx context.Context, ids []int) ([]Seat, error) {
 	var seats []Seat
-	if err := r.db.Preload("TheaterScreen").Preload("SeatCategory").
+	if err := r.db.WithContext(ctx).Preload("TheaterScreen").Preload("SeatCategory").
 		Where("id IN ?", ids).
 		Find(&seats).
 		Error; err != nil {
@@ -939,7 +941,7 @@ func (r *repository) GetSeatsByIds(ctx context.Context, ids []int) ([]Seat, erro
 
 func (r *repository) GetBooingsByScreenIDAndShowTimeID(ctx context.Context, screenId int, showtimeId int) ([]Booking, error) {
 	bookings := []Booking{}
-	if err := r.db.Where("screen_id = ? AND showtime_id = ?", screenId, showtimeId).Find(&bookings).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("screen_id = ? AND showtime_id = ?", screenId, showtimeId).Find(&bookings).Error; err != nil {
 		return nil, err
 	}
 	return bookings, nil
@@ -947,8 +949,32 @@ func (r *repository) GetBooingsByScreenIDAndShowTimeID(ctx context.Context, scre
 
 func (r *repository) GetBookingSeatsByBookingID(ctx context.Context, bookingIds []int) ([]BookingSeat, error) {
 	bookingSeats := []BookingSeat{}
-	if err := r.db.Where("booking_id IN ?", bookingIds).Find(&bookingSeats).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("booking_id IN ?", bookingIds).Find(&bookingSeats).Error; err != nil {
 		return nil, err
 	}
 	return bookingSeats, nil
 }
+
+func (r *repository) GetScreenTypeFormats(ctx context.Context, screenTypeId int) ([]string, error) {
+	formats := []string{}
+	if err := r.db.WithContext(ctx).Model(&ScreenTypeFormat{}).Where("screen_type_id = ?", screenTypeId).Pluck("format", &formats).Error; err != nil {
+		return nil, err
+	}
+	return formats, nil
+}
+
+func (r *repository) SetScreenTypeFormats(ctx context.Context, screenTypeId int, formats []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("screen_type_id = ?", screenTypeId).Delete(&ScreenTypeFormat{}).Error; err != nil {
+			return err
+		}
+		if len(formats) == 0 {
+			return nil
+		}
+		rows := make([]ScreenTypeFormat, len(formats))
+		for i, format := range formats {
+			rows[i] = ScreenTypeFormat{ScreenTypeID: uint(screenTypeId), Format: format}
+		}
+		return tx.Create(&rows).Error
+	})
+}
@@ -0,0 +1,77 @@
+package theatres
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+)
+
+// fakeRowsDB answers every query with rows rows without a database. Row i,
+// counting from 1, has i in each of its id and foreign key fields, so
+// preloads find the rows they reference. It returns the number of queries
+// run so far.
+func fakeRowsDB(t *testing.T, rows int) (*gorm.DB, func() int) {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	queries := 0
+	err = db.Callback().Query().Replace("gorm:query", func(tx *gorm.DB) {
+		callbacks.BuildQuerySQL(tx)
+		queries++
+		dest := reflect.ValueOf(tx.Statement.Dest).Elem()
+		if dest.Kind() != reflect.Slice {
+			return
+		}
+		for i := 1; i <= rows; i++ {
+			elem := reflect.New(dest.Type().Elem()).Elem()
+			row := elem
+			if row.Kind() == reflect.Ptr {
+				row.Set(reflect.New(row.Type().Elem()))
+				row = row.Elem()
+			}
+			for _, name := range []string{"ID", "MovieID", "ScreenID", "TheaterID", "TheaterTypeID"} {
+				if field := row.FieldByName(name); field.IsValid() {
+					field.Set(reflect.ValueOf(i).Convert(field.Type()))
+				}
+			}
+			dest.Set(reflect.Append(dest, elem))
+		}
+		tx.Statement.RowsAffected = int64(rows)
+	})
+	if err != nil {
+		t.Fatalf("replace query callback: %v", err)
+	}
+	return db, func() int { return queries }
+}
+
+func TestListShowtimesByShowDateAndMovieIDLoadsTheatersInBatch(t *testing.T) {
+	var perSize []int
+	for _, rows := range []int{1, 10} {
+		db, queries := fakeRowsDB(t, rows)
+		page, err := NewRepository(db).ListShowtimesByShowDateAndMovieID(context.Background(), time.Now(), 1, pagination.Request{})
+		if err != nil {
+			t.Fatalf("ListShowtimesByShowDateAndMovieID: %v", err)
+		}
+		for _, showtime := range page.Items {
+			theater := showtime.TheaterScreen.Theater
+			if theater.ID == 0 || theater.ID != uint(showtime.TheaterScreen.TheaterID) || theater.TheaterType.ID == 0 {
+				t.Fatalf("showtime %d has theater %+v", showtime.ID, theater)
+			}
+		}
+		perSize = append(perSize, queries())
+	}
+	if perSize[0] != perSize[1] {
+		t.Fatalf("queries grow with the number of showtimes: %v", perSize)
+	}
+}
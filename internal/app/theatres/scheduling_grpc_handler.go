@@ -0,0 +1,90 @@
+package theatres
+
+import (
+	"context"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/scheduling"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SchedulingGrpcHandler struct {
+	svc Service
+	scheduling.UnimplementedSchedulingServiceServer
+}
+
+func NewSchedulingGrpcHandler(svc Service) SchedulingGrpcHandler {
+	return SchedulingGrpcHandler{
+		svc: svc,
+	}
+}
+
+func (h *SchedulingGrpcHandler) SetScreenTypeFormats(ctx context.Context, req *scheduling.SetScreenTypeFormatsRequest) (*scheduling.SetScreenTypeFormatsResponse, error) {
+	formats := make([]string, len(req.Formats))
+	for i, format := range req.Formats {
+		name, ok := movies.FormatNames[format]
+		if !ok {
+			return nil, apperrors.InvalidArgument("invalid format %v", format)
+		}
+		formats[i] = name
+	}
+	if err := h.svc.SetScreenTypeFormats(ctx, int(req.ScreenTypeId), formats); err != nil {
+		return nil, err
+	}
+	return &scheduling.SetScreenTypeFormatsResponse{}, nil
+}
+
+func (h *SchedulingGrpcHandler) GetScreenTypeFormats(ctx context.Context, req *scheduling.GetScreenTypeFormatsRequest) (*scheduling.GetScreenTypeFormatsResponse, error) {
+	formats, err := h.svc.GetScreenTypeFormats(ctx, int(req.ScreenTypeId))
+	if err != nil {
+		return nil, err
+	}
+	response := make([]catalog.Format, len(formats))
+	for i, format := range formats {
+		response[i] = movies.ToCatalogFormat(format)
+	}
+	return &scheduling.GetScreenTypeFormatsResponse{Formats: response}, nil
+}
+
+func (h *SchedulingGrpcHandler) AddShowtime(ctx context.Context, req *scheduling.AddShowtimeRequest) (*scheduling.AddShowtimeResponse, error) {
+	if req.MovieVersionId == 0 {
+		return nil, apperrors.InvalidArgument("movie version id is required")
+	}
+	showtimeId, err := h.svc.AddShowtime(ctx, Showtime{
+		MovieVersionID: int(req.MovieVersionId),
+		ScreenID:       int(req.ScreenId),
+		ShowDate:       req.ShowDate.AsTime(),
+		ShowTime:       req.ShowTime.AsTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &scheduling.AddShowtimeResponse{ShowtimeId: uint32(showtimeId)}, nil
+}
+
+func (h *SchedulingGrpcHandler) SetShowtimeVersion(ctx context.Context, req *scheduling.SetShowtimeVersionRequest) (*scheduling.SetShowtimeVersionResponse, error) {
+	if err := h.svc.SetShowtimeVersion(ctx, int(req.ShowtimeId), int(req.MovieVersionId)); err != nil {
+		return nil, err
+	}
+	return &scheduling.SetShowtimeVersionResponse{}, nil
+}
+
+func (h *SchedulingGrpcHandler) GetShowtime(ctx context.Context, req *scheduling.GetShowtimeRequest) (*scheduling.GetShowtimeResponse, error) {
+	showtime, version, err := h.svc.GetShowtimeWithVersion(ctx, int(req.ShowtimeId))
+	if err != nil {
+		return nil, err
+	}
+	response := &scheduling.GetShowtimeResponse{
+		ShowtimeId: uint32(showtime.ID),
+		MovieId:    uint32(showtime.MovieID),
+		ScreenId:   uint32(showtime.ScreenID),
+		ShowDate:   timestamppb.New(showtime.ShowDate),
+		ShowTime:   timestamppb.New(showtime.ShowTime),
+	}
+	if version != nil {
+		response.Version = movies.ToCatalogVersion(*version)
+	}
+	return response, nil
+}
@@ -2,17 +2,27 @@ package theatres
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pagination"
+
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/rbac"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	grpclient "github.com/aparnasukesh/movies-booking-svc/pkg/grpClient"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
 type service struct {
 	repo      Repository
 	movieRepo movies.Repository
+	rbac      rbac.Service
+	users     grpclient.UserDirectory
 }
 type Service interface {
 	// theater type
@@ -31,6 +41,8 @@ type Service interface {
 	GetScreenTypeByName(ctx context.Context, name string) (*ScreenType, error)
 	UpdateScreenType(ctx context.Context, id int, screenType ScreenType) error
 	ListScreenTypes(ctx context.Context) ([]ScreenType, error)
+	GetScreenTypeFormats(ctx context.Context, screenTypeId int) ([]string, error)
+	SetScreenTypeFormats(ctx context.Context, screenTypeId int, formats []string) error
 	// Seat category
 	AddSeatCategory(ctx context.Context, seatCategory SeatCategory) error
 	DeleteSeatCategoryByID(ctx context.Context, id int) error
@@ -46,36 +58,38 @@ type Service interface {
 	GetTheaterByID(ctx context.Context, id int) (*Theater, error)
 	GetTheaterByName(ctx context.Context, name string) ([]Theater, error)
 	UpdateTheater(ctx context.Context, theaterID uint, input TheaterUpdateInput) error
-	ListTheaters(ctx context.Context) ([]TheaterWithTypeResponse, error)
-	GetTheatersByCity(ctx context.Context, city string) ([]Theater, error)
+	ListTheaters(ctx context.Context, page pagination.Request) ([]TheaterWithTypeResponse, string, error)
+	GetTheatersByCity(ctx context.Context, city string, page pagination.Request) ([]Theater, string, error)
 	GetTheatersAndMovieScheduleByMovieName(ctx context.Context, movieName string) ([]MovieSchedule, *movies.Movie, error)
 	GetScreensAndMovieSchedulesByTheaterID(ctx context.Context, theaterId int) ([]TheaterScreen, []MovieSchedule, *Theater, error)
 	//Theater screen
-	AddTheaterScreen(ctx context.Context, theaterScreen TheaterScreen, ownerId int) error
+	AddTheaterScreen(ctx context.Context, theaterScreen TheaterScreen) error
 	DeleteTheaterScreenByID(ctx context.Context, id int) error
 	DeleteTheaterScreenByNumber(ctx context.Context, theaterID int, screenNumber int) error
 	GetTheaterScreenByID(ctx context.Context, id int) (*TheaterScreen, error)
 	GetTheaterScreenByNumber(ctx context.Context, theaterID int, screenNumber int) (*TheaterScreen, error)
-	UpdateTheaterScreen(ctx context.Context, id int, theaterScreen TheaterScreen, ownerId int) error
+	UpdateTheaterScreen(ctx context.Context, id int, theaterScreen TheaterScreen) error
 	ListTheaterScreens(ctx context.Context, theaterId int) ([]TheaterScreen, error)
 	//Show time
-	AddShowtime(ctx context.Context, showtime Showtime, ownerId int) error
+	AddShowtime(ctx context.Context, showtime Showtime) (int, error)
+	SetShowtimeVersion(ctx context.Context, id int, versionId int) error
+	GetShowtimeWithVersion(ctx context.Context, id int) (*Showtime, *movies.MovieVersion, error)
 	DeleteShowtimeByID(ctx context.Context, id int) error
 	DeleteShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) error
 	GetShowtimeByID(ctx context.Context, id int) (*Showtime, error)
 	GetShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) (*Showtime, error)
-	UpdateShowtime(ctx context.Context, id int, showtime Showtime, ownerId int) error
-	ListShowtimes(ctx context.Context, movieID int) ([]Showtime, error)
-	ListShowTimeByTheaterID(ctx context.Context, theaterId int) ([]Showtime, *Theater, error)
-	ListShowTimeByTheaterIDandMovieID(ctx context.Context, theaterId, movieId int) ([]Showtime, *Theater, *movies.Movie, error)
-	ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int) ([]Showtime, error)
+	UpdateShowtime(ctx context.Context, id int, showtime Showtime) error
+	ListShowtimes(ctx context.Context, movieID int, page pagination.Request) ([]Showtime, string, error)
+	ListShowTimeByTheaterID(ctx context.Context, theaterId int, page pagination.Request) ([]Showtime, *Theater, string, error)
+	ListShowTimeByTheaterIDandMovieID(ctx context.Context, theaterId, movieId int, page pagination.Request) ([]Showtime, *Theater, *movies.Movie, string, error)
+	ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int, page pagination.Request) ([]Showtime, string, error)
 	// Movie Schedule
-	AddMovieSchedule(ctx context.Context, movieSchedule MovieSchedule, ownerId int) error
-	UpdateMovieSchedule(ctx context.Context, id int, updateData MovieSchedule, ownerId int) error
-	GetAllMovieSchedules(ctx context.Context) ([]MovieSchedule, error)
-	GetMovieScheduleByMovieID(ctx context.Context, id int) ([]MovieSchedule, error)
-	GetMovieScheduleByTheaterID(ctx context.Context, id int) ([]MovieSchedule, error)
-	GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId, theaterId int) ([]MovieSchedule, error)
+	AddMovieSchedule(ctx context.Context, movieSchedule MovieSchedule) error
+	UpdateMovieSchedule(ctx context.Context, id int, updateData MovieSchedule) error
+	GetAllMovieSchedules(ctx context.Context, page pagination.Request) ([]MovieSchedule, string, error)
+	GetMovieScheduleByMovieID(ctx context.Context, id int, page pagination.Request) ([]MovieSchedule, string, error)
+	GetMovieScheduleByTheaterID(ctx context.Context, id int, page pagination.Request) ([]MovieSchedule, string, error)
+	GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId, theaterId int, page pagination.Request) ([]MovieSchedule, string, error)
 	GetMovieScheduleByMovieIdAndShowTimeId(ctx context.Context, movieId, showTimeId int) ([]MovieSchedule, error)
 	GetMovieScheduleByTheaterIdAndShowTimeId(ctx context.Context, theaterId, showTimeId int) ([]MovieSchedule, error)
 	GetMovieScheduleByID(ctx context.Context, id int) (*MovieSchedule, error)
@@ -83,7 +97,7 @@ type Service interface {
 	DeleteMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId, theaterId int) error
 	DeleteMovieScheduleByMovieIdAndTheaterIdAndShowTimeId(ctx context.Context, movieId, theaterId, showTimeId int) error
 	// Seats
-	CreateSeats(ctx context.Context, req CreateSeatsRequest, ownerId int) error
+	CreateSeats(ctx context.Context, req CreateSeatsRequest) error
 	GetSeatsByScreenId(ctx context.Context, screenId int) ([]Seat, error)
 	GetAvailableSeatsByScreenIdAndShowTimeID(ctx context.Context, screenId int, showtimeId int) ([]Seat, error)
 	GetSeatById(ctx context.Context, id int) (*Seat, error)
@@ -92,35 +106,37 @@ type Service interface {
 	DeleteSeatBySeatNumberAndScreenId(ctx context.Context, screenId int, seatNumber string) error
 }
 
-func NewService(repo Repository, movieRepo movies.Repository) Service {
+func NewService(repo Repository, movieRepo movies.Repository, rbacSvc rbac.Service, users grpclient.UserDirectory) Service {
 	return &service{
 		repo:      repo,
 		movieRepo: movieRepo,
+		rbac:      rbacSvc,
+		users:     users,
 	}
 }
 
 // Showtime
-func (s *service) ListShowTimeByTheaterIDandMovieID(ctx context.Context, theaterId, movieId int) ([]Showtime, *Theater, *movies.Movie, error) {
+func (s *service) ListShowTimeByTheaterIDandMovieID(ctx context.Context, theaterId, movieId int, page pagination.Request) ([]Showtime, *Theater, *movies.Movie, string, error) {
 	theater, err := s.repo.GetTheaterByID(ctx, theaterId)
 	if err != nil && err != gorm.ErrRecordNotFound {
-		return nil, nil, nil, err
+		return nil, nil, nil, "", err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, nil, nil, fmt.Errorf("theater is not found with id %d,theater id is invalid", theaterId)
+		return nil, nil, nil, "", apperrors.NotFound("theater is not found with id %d,theater id is invalid", theaterId)
 	}
 	movie, err := s.movieRepo.GetMovieDetailsById(ctx, movieId)
 	if err != nil && err != gorm.ErrRecordNotFound {
-		return nil, nil, nil, err
+		return nil, nil, nil, "", err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, nil, nil, fmt.Errorf("movie is not found with id %d,movie id is invalid", movieId)
+		return nil, nil, nil, "", apperrors.NotFound("movie is not found with id %d,movie id is invalid", movieId)
 	}
 	theaterScreens, err := s.repo.GetTheaterScreenByTheaterID(ctx, theaterId)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, "", err
 	}
 	if len(theaterScreens) < 1 {
-		return nil, nil, nil, fmt.Errorf("no theater screens found with this theater id %d", theaterId)
+		return nil, nil, nil, "", apperrors.NotFound("no theater screens found with this theater id %d", theaterId)
 	}
 
 	screenIDs := make([]int, len(theaterScreens))
@@ -128,32 +144,32 @@ func (s *service) ListShowTimeByTheaterIDandMovieID(ctx context.Context, theater
 		screenIDs[i] = int(screen.ID)
 	}
 
-	showtimes, err := s.repo.ListShowTimeByTheaterID(ctx, screenIDs)
+	showtimes, err := s.repo.ListShowTimeByTheaterIDandMovieID(ctx, screenIDs, movieId, page)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, "", err
 	}
 
-	if len(showtimes) < 1 {
-		return nil, nil, nil, fmt.Errorf("no showtimes found with thieater id %d", theaterId)
+	if len(showtimes.Items) < 1 {
+		return nil, nil, nil, "", apperrors.NotFound("no showtimes found with thieater id %d", theaterId)
 	}
 
-	return showtimes, theater, movie, nil
+	return showtimes.Items, theater, movie, showtimes.NextPageToken, nil
 }
 
-func (s *service) ListShowTimeByTheaterID(ctx context.Context, theaterId int) ([]Showtime, *Theater, error) {
+func (s *service) ListShowTimeByTheaterID(ctx context.Context, theaterId int, page pagination.Request) ([]Showtime, *Theater, string, error) {
 	theater, err := s.repo.GetTheaterByID(ctx, theaterId)
 	if err != nil && err != gorm.ErrRecordNotFound {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, nil, fmt.Errorf("theater is not found with id %d,theater id is invalid", theaterId)
+		return nil, nil, "", apperrors.NotFound("theater is not found with id %d,theater id is invalid", theaterId)
 	}
 	theaterScreens, err := s.repo.GetTheaterScreenByTheaterID(ctx, theaterId)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
 	if len(theaterScreens) < 1 {
-		return nil, nil, fmt.Errorf("no theater screens found with this theater id %d", theaterId)
+		return nil, nil, "", apperrors.NotFound("no theater screens found with this theater id %d", theaterId)
 	}
 
 	screenIDs := make([]int, len(theaterScreens))
@@ -161,16 +177,16 @@ func (s *service) ListShowTimeByTheaterID(ctx context.Context, theaterId int) ([
 		screenIDs[i] = int(screen.ID)
 	}
 
-	showtimes, err := s.repo.ListShowTimeByTheaterID(ctx, screenIDs)
+	showtimes, err := s.repo.ListShowTimeByTheaterID(ctx, screenIDs, page)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
 
-	if len(showtimes) < 1 {
-		return nil, nil, fmt.Errorf("no showtimes found with thieater id %d", theaterId)
+	if len(showtimes.Items) < 1 {
+		return nil, nil, "", apperrors.NotFound("no showtimes found with thieater id %d", theaterId)
 	}
 
-	return showtimes, theater, nil
+	return showtimes.Items, theater, showtimes.NextPageToken, nil
 }
 
 // Theaters
@@ -178,7 +194,7 @@ func (s *service) GetScreensAndMovieSchedulesByTheaterID(ctx context.Context, th
 	theater, err := s.repo.GetTheaterByID(ctx, theaterId)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, nil, nil, fmt.Errorf("theater with ID %d does not exist", theaterId)
+			return nil, nil, nil, apperrors.NotFound("theater with ID %d does not exist", theaterId)
 		}
 		return nil, nil, nil, fmt.Errorf("failed to fetch theater details: %w", err)
 	}
@@ -188,7 +204,7 @@ func (s *service) GetScreensAndMovieSchedulesByTheaterID(ctx context.Context, th
 		return nil, nil, nil, fmt.Errorf("failed to fetch movie schedules for theater ID %d: %w", theaterId, err)
 	}
 	if len(movieSchedules) == 0 {
-		return nil, nil, nil, fmt.Errorf("no movie schedules found for theater ID %d", theaterId)
+		return nil, nil, nil, apperrors.NotFound("no movie schedules found for theater ID %d", theaterId)
 	}
 
 	for i, schedule := range movieSchedules {
@@ -212,20 +228,20 @@ func (s *service) GetScreensAndMovieSchedulesByTheaterID(ctx context.Context, th
 		return nil, nil, nil, fmt.Errorf("failed to fetch theater screens for theater ID %d: %w", theaterId, err)
 	}
 	if len(theaterScreens) == 0 {
-		return nil, nil, nil, fmt.Errorf("no theater screens found for theater ID %d", theaterId)
+		return nil, nil, nil, apperrors.NotFound("no theater screens found for theater ID %d", theaterId)
 	}
 
 	return theaterScreens, movieSchedules, theater, nil
 }
-func (s *service) GetTheatersByCity(ctx context.Context, city string) ([]Theater, error) {
-	theaters, err := s.repo.GetTheatersByCity(ctx, city)
+func (s *service) GetTheatersByCity(ctx context.Context, city string, page pagination.Request) ([]Theater, string, error) {
+	theaters, err := s.repo.GetTheatersByCity(ctx, city, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(theaters) < 1 {
-		return nil, fmt.Errorf("no theaters found in city with name %s", city)
+	if len(theaters.Items) < 1 {
+		return nil, "", apperrors.NotFound("no theaters found in city with name %s", city)
 	}
-	return theaters, nil
+	return theaters.Items, theaters.NextPageToken, nil
 }
 
 func (s *service) GetTheatersAndMovieScheduleByMovieName(ctx context.Context, movieName string) ([]MovieSchedule, *movies.Movie, error) {
@@ -234,29 +250,29 @@ func (s *service) GetTheatersAndMovieScheduleByMovieName(ctx context.Context, mo
 		return nil, nil, err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, nil, fmt.Errorf("movie not found with name %s", movieName)
+		return nil, nil, apperrors.NotFound("movie not found with name %s", movieName)
 	}
 	movieSchedule, err := s.repo.GetTheatersAndMovieScheduleByMovieName(ctx, int(movie.ID))
 	if err != nil {
 		return nil, nil, err
 	}
 	if len(movieSchedule) < 1 {
-		return nil, nil, fmt.Errorf("no movie schedule found with movie name %s", movieName)
+		return nil, nil, apperrors.NotFound("no movie schedule found with movie name %s", movieName)
 	}
 	return movieSchedule, movie, nil
 }
 
 // Seats
-func (s *service) CreateSeats(ctx context.Context, req CreateSeatsRequest, ownerId int) error {
+func (s *service) CreateSeats(ctx context.Context, req CreateSeatsRequest) error {
 	theaterScreen, err := s.repo.GetTheaterScreenByID(ctx, req.ScreenId)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("no theater screen found with screen id %d", req.ScreenId)
+			return apperrors.NotFound("no theater screen found with screen id %d", req.ScreenId)
 		}
 		return err
 	}
-	if theaterScreen.Theater.OwnerID != uint(ownerId) {
-		return fmt.Errorf("unauthorized: only theater's admin can add seats")
+	if err := s.authorizeTheater(ctx, theaterScreen.TheaterID, rbac.PermManageTheater, "add seats"); err != nil {
+		return err
 	}
 	totalColumns := req.TotalColumns
 	screenID := req.ScreenId
@@ -266,7 +282,7 @@ func (s *service) CreateSeats(ctx context.Context, req CreateSeatsRequest, owner
 		_, err := s.repo.GetSeatCategoryByID(ctx, category.SeatCategoryId)
 		if err != nil {
 			if err == gorm.ErrRecordNotFound {
-				return fmt.Errorf("no seat category found with id %d", category.SeatCategoryId)
+				return apperrors.NotFound("no seat category found with id %d", category.SeatCategoryId)
 			}
 			return err
 		}
@@ -308,7 +324,17 @@ func (s *service) CreateSeats(ctx context.Context, req CreateSeatsRequest, owner
 }
 
 func (s *service) DeleteSeatById(ctx context.Context, id int) error {
-	err := s.repo.DeleteSeatById(ctx, id)
+	seat, err := s.repo.GetSeatById(ctx, id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("no seat found with id %d", id)
+		}
+		return err
+	}
+	if err := s.authorizeTheater(ctx, seat.TheaterScreen.TheaterID, rbac.PermManageTheater, "delete seat"); err != nil {
+		return err
+	}
+	err = s.repo.DeleteSeatById(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -316,6 +342,9 @@ func (s *service) DeleteSeatById(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteSeatBySeatNumberAndScreenId(ctx context.Context, screenId int, seatNumber string) error {
+	if err := s.authorizeScreen(ctx, screenId, rbac.PermManageTheater, "delete seat"); err != nil {
+		return err
+	}
 	err := s.repo.DeleteSeatBySeatNumberAndScreenId(ctx, screenId, seatNumber)
 	if err != nil {
 		return err
@@ -329,7 +358,7 @@ func (s *service) GetSeatById(ctx context.Context, id int) (*Seat, error) {
 		return nil, err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("no seat found with %d", id)
+		return nil, apperrors.NotFound("no seat found with %d", id)
 	}
 	return seat, nil
 }
@@ -340,7 +369,7 @@ func (s *service) GetSeatBySeatNumberAndScreenId(ctx context.Context, screenId i
 		return nil, err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("no seat found with seatnumber %s and screenid %d", seatNumber, screenId)
+		return nil, apperrors.NotFound("no seat found with seatnumber %s and screenid %d", seatNumber, screenId)
 	}
 	return seat, nil
 }
@@ -348,7 +377,7 @@ func (s *service) GetSeatBySeatNumberAndScreenId(ctx context.Context, screenId i
 func (s *service) GetSeatsByScreenId(ctx context.Context, screenId int) ([]Seat, error) {
 	seats, err := s.repo.GetSeatsByScreenId(ctx, screenId)
 	if len(seats) < 1 {
-		return nil, fmt.Errorf("no seats found")
+		return nil, apperrors.NotFound("no seats found")
 	}
 	if err != nil {
 		return nil, err
@@ -359,7 +388,7 @@ func (s *service) GetSeatsByScreenId(ctx context.Context, screenId int) ([]Seat,
 func (s *service) GetAvailableSeatsByScreenIdAndShowTimeID(ctx context.Context, screenId int, showtimeId int) ([]Seat, error) {
 	_, err := s.repo.GetShowtimeByID(ctx, showtimeId)
 	if err != nil && err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("invalid showtime id %d", showtimeId)
+		return nil, apperrors.InvalidArgument("invalid showtime id %d", showtimeId)
 	}
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
@@ -382,7 +411,7 @@ func (s *service) GetAvailableSeatsByScreenIdAndShowTimeID(ctx context.Context,
 		return nil, err
 	}
 	if len(allSeats) < 1 {
-		return nil, fmt.Errorf("no seats found with screen id %d", screenId)
+		return nil, apperrors.NotFound("no seats found with screen id %d", screenId)
 	}
 	for i := 0; i < len(allSeats); i++ {
 		flag := 0
@@ -401,28 +430,28 @@ func (s *service) GetAvailableSeatsByScreenIdAndShowTimeID(ctx context.Context,
 }
 
 // Movie Schedule
-func (s *service) AddMovieSchedule(ctx context.Context, movieSchedule MovieSchedule, ownerId int) error {
+func (s *service) AddMovieSchedule(ctx context.Context, movieSchedule MovieSchedule) error {
 	movie, err := s.movieRepo.GetMovieDetailsById(ctx, movieSchedule.MovieID)
 	if err != nil && movie == nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("movie with ID %d does not exist", movieSchedule.MovieID)
+			return apperrors.NotFound("movie with ID %d does not exist", movieSchedule.MovieID)
 		}
 		return fmt.Errorf("failed to fetch movie details: %w", err)
 	}
 	theater, err := s.repo.GetTheaterByID(ctx, movieSchedule.TheaterID)
 	if err != nil && theater == nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("theater with ID %d does not exist", movieSchedule.TheaterID)
+			return apperrors.NotFound("theater with ID %d does not exist", movieSchedule.TheaterID)
 		}
 		return fmt.Errorf("failed to fetch theater details: %w", err)
 	}
-	if theater.OwnerID != uint(ownerId) {
-		return fmt.Errorf("unauthorized: only theater's admin can add movie schedule")
+	if err := s.authorizeTheater(ctx, int(theater.ID), rbac.PermManageShows, "add movie schedule"); err != nil {
+		return err
 	}
 	showtime, err := s.repo.GetShowtimeByID(ctx, movieSchedule.ShowtimeID)
 	if err != nil && showtime == nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("showtime with ID %d does not exist", movieSchedule.ShowtimeID)
+			return apperrors.NotFound("showtime with ID %d does not exist", movieSchedule.ShowtimeID)
 		}
 		return fmt.Errorf("failed to fetch showtime details: %w", err)
 	}
@@ -446,11 +475,21 @@ func (s *service) AddMovieSchedule(ctx context.Context, movieSchedule MovieSched
 		return nil
 	}
 
-	return errors.New("schedule already exists")
+	return apperrors.AlreadyExists("schedule already exists")
 }
 
 func (s *service) DeleteMovieScheduleById(ctx context.Context, id int) error {
-	err := s.repo.DeleteMovieScheduleById(ctx, id)
+	movieSchedule, err := s.repo.GetMovieScheduleByID(ctx, id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("no movie schedule found with ID %d", id)
+		}
+		return err
+	}
+	if err := s.authorizeTheater(ctx, movieSchedule.TheaterID, rbac.PermManageShows, "delete movie schedule"); err != nil {
+		return err
+	}
+	err = s.repo.DeleteMovieScheduleById(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -458,6 +497,9 @@ func (s *service) DeleteMovieScheduleById(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId int, theaterId int) error {
+	if err := s.authorizeTheater(ctx, theaterId, rbac.PermManageShows, "delete movie schedule"); err != nil {
+		return err
+	}
 	err := s.repo.DeleteMovieScheduleByMovieIdAndTheaterId(ctx, movieId, theaterId)
 	if err != nil {
 		return err
@@ -466,21 +508,24 @@ func (s *service) DeleteMovieScheduleByMovieIdAndTheaterId(ctx context.Context,
 }
 
 func (s *service) DeleteMovieScheduleByMovieIdAndTheaterIdAndShowTimeId(ctx context.Context, movieId int, theaterId int, showTimeId int) error {
+	if err := s.authorizeTheater(ctx, theaterId, rbac.PermManageShows, "delete movie schedule"); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteMovieScheduleByMovieIdAndTheaterIdAndShowTimeId(ctx, movieId, theaterId, showTimeId); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *service) GetAllMovieSchedules(ctx context.Context) ([]MovieSchedule, error) {
-	movieSchedules, err := s.repo.GetAllMovieSchedules(ctx)
+func (s *service) GetAllMovieSchedules(ctx context.Context, page pagination.Request) ([]MovieSchedule, string, error) {
+	movieSchedules, err := s.repo.GetAllMovieSchedules(ctx, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(movieSchedules) < 1 {
-		return nil, fmt.Errorf("no movie schedule found")
+	if len(movieSchedules.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movie schedule found")
 	}
-	return movieSchedules, nil
+	return movieSchedules.Items, movieSchedules.NextPageToken, nil
 }
 
 func (s *service) GetMovieScheduleByID(ctx context.Context, id int) (*MovieSchedule, error) {
@@ -489,20 +534,20 @@ func (s *service) GetMovieScheduleByID(ctx context.Context, id int) (*MovieSched
 		return nil, err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("no movie schedule found with id %d", id)
+		return nil, apperrors.NotFound("no movie schedule found with id %d", id)
 	}
 	return movieSchedule, nil
 }
 
-func (s *service) GetMovieScheduleByMovieID(ctx context.Context, movieId int) ([]MovieSchedule, error) {
-	movieSchedules, err := s.repo.GetMovieScheduleByMovieID(ctx, movieId)
+func (s *service) GetMovieScheduleByMovieID(ctx context.Context, movieId int, page pagination.Request) ([]MovieSchedule, string, error) {
+	movieSchedules, err := s.repo.GetMovieScheduleByMovieID(ctx, movieId, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(movieSchedules) < 1 {
-		return nil, fmt.Errorf("no movie schedules found for movie with movie id %d", movieId)
+	if len(movieSchedules.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movie schedules found for movie with movie id %d", movieId)
 	}
-	return movieSchedules, nil
+	return movieSchedules.Items, movieSchedules.NextPageToken, nil
 }
 
 func (s *service) GetMovieScheduleByMovieIdAndShowTimeId(ctx context.Context, movieId int, showTimeId int) ([]MovieSchedule, error) {
@@ -511,31 +556,31 @@ func (s *service) GetMovieScheduleByMovieIdAndShowTimeId(ctx context.Context, mo
 		return nil, err
 	}
 	if len(movieSchedules) < 1 {
-		return nil, fmt.Errorf("no movie schedules found for movie with movie id %d and showtime id %d", movieId, showTimeId)
+		return nil, apperrors.NotFound("no movie schedules found for movie with movie id %d and showtime id %d", movieId, showTimeId)
 	}
 	return movieSchedules, nil
 }
 
-func (s *service) GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId int, theaterId int) ([]MovieSchedule, error) {
-	movieSchedules, err := s.repo.GetMovieScheduleByMovieIdAndTheaterId(ctx, movieId, theaterId)
+func (s *service) GetMovieScheduleByMovieIdAndTheaterId(ctx context.Context, movieId int, theaterId int, page pagination.Request) ([]MovieSchedule, string, error) {
+	movieSchedules, err := s.repo.GetMovieScheduleByMovieIdAndTheaterId(ctx, movieId, theaterId, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(movieSchedules) < 1 {
-		return nil, fmt.Errorf("no movie schedules found for movie with movie id %d and theater id %d", movieId, theaterId)
+	if len(movieSchedules.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movie schedules found for movie with movie id %d and theater id %d", movieId, theaterId)
 	}
-	return movieSchedules, nil
+	return movieSchedules.Items, movieSchedules.NextPageToken, nil
 }
 
-func (s *service) GetMovieScheduleByTheaterID(ctx context.Context, theaterId int) ([]MovieSchedule, error) {
-	movieSchedules, err := s.repo.GetMovieScheduleByTheaterID(ctx, theaterId)
+func (s *service) GetMovieScheduleByTheaterID(ctx context.Context, theaterId int, page pagination.Request) ([]MovieSchedule, string, error) {
+	movieSchedules, err := s.repo.ListMovieSchedulesByTheaterID(ctx, theaterId, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(movieSchedules) < 1 {
-		return nil, fmt.Errorf("no movie schedules found with theater id %d", theaterId)
+	if len(movieSchedules.Items) < 1 {
+		return nil, "", apperrors.NotFound("no movie schedules found with theater id %d", theaterId)
 	}
-	return movieSchedules, nil
+	return movieSchedules.Items, movieSchedules.NextPageToken, nil
 }
 
 func (s *service) GetMovieScheduleByTheaterIdAndShowTimeId(ctx context.Context, theaterId int, showTimeId int) ([]MovieSchedule, error) {
@@ -544,25 +589,25 @@ func (s *service) GetMovieScheduleByTheaterIdAndShowTimeId(ctx context.Context,
 		return nil, err
 	}
 	if len(movieSchedules) < 1 {
-		return nil, fmt.Errorf("no movie schedules found with theater id %d and showtime id %d", theaterId, showTimeId)
+		return nil, apperrors.NotFound("no movie schedules found with theater id %d and showtime id %d", theaterId, showTimeId)
 	}
 	return movieSchedules, nil
 }
-func (s *service) UpdateMovieSchedule(ctx context.Context, id int, updateData MovieSchedule, ownerid int) error {
+func (s *service) UpdateMovieSchedule(ctx context.Context, id int, updateData MovieSchedule) error {
 	data, err := s.repo.GetMovieScheduleByID(ctx, id)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("no movie schedule found with ID %d", id)
+			return apperrors.NotFound("no movie schedule found with ID %d", id)
 		}
 		return fmt.Errorf("failed to retrieve movie schedule: %w", err)
 	}
-	if data.Theater.OwnerID != uint(ownerid) {
-		return fmt.Errorf("unauthorized: only theater's admin can update the movie schedule")
+	if err := s.authorizeTheater(ctx, data.TheaterID, rbac.PermManageShows, "update movie schedule"); err != nil {
+		return err
 	}
 	if updateData.MovieID != 0 {
 		if _, err := s.movieRepo.GetMovieDetailsById(ctx, updateData.MovieID); err != nil {
 			if err == gorm.ErrRecordNotFound {
-				return fmt.Errorf("movie with ID %d does not exist", updateData.MovieID)
+				return apperrors.NotFound("movie with ID %d does not exist", updateData.MovieID)
 			}
 			return fmt.Errorf("failed to fetch movie details: %w", err)
 		}
@@ -571,16 +616,19 @@ func (s *service) UpdateMovieSchedule(ctx context.Context, id int, updateData Mo
 	if updateData.TheaterID != 0 {
 		if _, err := s.repo.GetTheaterByID(ctx, updateData.TheaterID); err != nil {
 			if err == gorm.ErrRecordNotFound {
-				return fmt.Errorf("theater with ID %d does not exist", updateData.TheaterID)
+				return apperrors.NotFound("theater with ID %d does not exist", updateData.TheaterID)
 			}
 			return fmt.Errorf("failed to fetch theater details: %w", err)
 		}
+		if err := s.authorizeTheater(ctx, updateData.TheaterID, rbac.PermManageShows, "move movie schedule"); err != nil {
+			return err
+		}
 	}
 
 	if updateData.ShowtimeID != 0 {
 		if _, err := s.repo.GetShowtimeByID(ctx, updateData.ShowtimeID); err != nil {
 			if err == gorm.ErrRecordNotFound {
-				return fmt.Errorf("showtime with ID %d does not exist", updateData.ShowtimeID)
+				return apperrors.NotFound("showtime with ID %d does not exist", updateData.ShowtimeID)
 			}
 			return fmt.Errorf("failed to fetch showtime details: %w", err)
 		}
@@ -605,9 +653,12 @@ func (s *service) UpdateMovieSchedule(ctx context.Context, id int, updateData Mo
 
 // Theater-Type
 func (s *service) AddTheaterType(ctx context.Context, theaterType TheaterType) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	res, err := s.repo.FindTheatertypeByName(ctx, theaterType.TheaterTypeName)
 	if res != nil && err == nil {
-		return errors.New("theater type already exist")
+		return apperrors.AlreadyExists("theater type already exist")
 	}
 	if err != gorm.ErrRecordNotFound {
 		return err
@@ -619,6 +670,9 @@ func (s *service) AddTheaterType(ctx context.Context, theaterType TheaterType) e
 }
 
 func (s *service) DeleteTheaterTypeByID(ctx context.Context, id int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteTheaterTypeByID(ctx, id); err != nil {
 		return err
 	}
@@ -626,6 +680,9 @@ func (s *service) DeleteTheaterTypeByID(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteTheaterTypeByName(ctx context.Context, name string) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteTheaterTypeByName(ctx, name); err != nil {
 		return err
 	}
@@ -654,12 +711,15 @@ func (s *service) ListTheaterTypes(ctx context.Context) ([]TheaterType, error) {
 		return nil, err
 	}
 	if len(theaterTypes) < 1 {
-		return nil, errors.New("theater types are not found")
+		return nil, apperrors.NotFound("theater types are not found")
 	}
 	return theaterTypes, nil
 }
 
 func (s *service) UpdateTheaterType(ctx context.Context, id int, theaterType TheaterType) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	err := s.repo.UpdateTheaterType(ctx, id, theaterType)
 	if err != nil {
 		return err
@@ -669,9 +729,12 @@ func (s *service) UpdateTheaterType(ctx context.Context, id int, theaterType The
 
 // screen type
 func (s *service) AddScreenType(ctx context.Context, screenType ScreenType) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	res, err := s.repo.FindScreenTypeByName(ctx, screenType.ScreenTypeName)
 	if res != nil && err == nil {
-		return errors.New("screen type already exists")
+		return apperrors.AlreadyExists("screen type already exists")
 	}
 	if err != gorm.ErrRecordNotFound {
 		return err
@@ -683,6 +746,9 @@ func (s *service) AddScreenType(ctx context.Context, screenType ScreenType) erro
 }
 
 func (s *service) DeleteScreenTypeByID(ctx context.Context, id int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteScreenTypeByID(ctx, id); err != nil {
 		return err
 	}
@@ -690,6 +756,9 @@ func (s *service) DeleteScreenTypeByID(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteScreenTypeByName(ctx context.Context, name string) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteScreenTypeByName(ctx, name); err != nil {
 		return err
 	}
@@ -718,12 +787,15 @@ func (s *service) ListScreenTypes(ctx context.Context) ([]ScreenType, error) {
 		return nil, err
 	}
 	if len(screenTypes) < 1 {
-		return nil, errors.New("no screen types found")
+		return nil, apperrors.NotFound("no screen types found")
 	}
 	return screenTypes, nil
 }
 
 func (s *service) UpdateScreenType(ctx context.Context, id int, screenType ScreenType) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	err := s.repo.UpdateScreenType(ctx, id, screenType)
 	if err != nil {
 		return err
@@ -733,9 +805,12 @@ func (s *service) UpdateScreenType(ctx context.Context, id int, screenType Scree
 
 // seat category
 func (s *service) AddSeatCategory(ctx context.Context, seatCategory SeatCategory) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	res, err := s.repo.FindSeatCategoryByName(ctx, seatCategory.SeatCategoryName)
 	if res != nil && err == nil {
-		return errors.New("seat category already exists")
+		return apperrors.AlreadyExists("seat category already exists")
 	}
 	if err != gorm.ErrRecordNotFound {
 		return err
@@ -747,6 +822,9 @@ func (s *service) AddSeatCategory(ctx context.Context, seatCategory SeatCategory
 }
 
 func (s *service) DeleteSeatCategoryByID(ctx context.Context, id int) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteSeatCategoryByID(ctx, id); err != nil {
 		return err
 	}
@@ -754,6 +832,9 @@ func (s *service) DeleteSeatCategoryByID(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteSeatCategoryByName(ctx context.Context, name string) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteSeatCategoryByName(ctx, name); err != nil {
 		return err
 	}
@@ -777,6 +858,9 @@ func (s *service) GetSeatCategoryByName(ctx context.Context, name string) (*Seat
 }
 
 func (s *service) UpdateSeatCategory(ctx context.Context, id int, seatCategory SeatCategory) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
 	err := s.repo.UpdateSeatCategory(ctx, id, seatCategory)
 	if err != nil {
 		return err
@@ -790,44 +874,54 @@ func (s *service) ListSeatCategories(ctx context.Context) ([]SeatCategory, error
 		return nil, err
 	}
 	if len(seatCategories) < 1 {
-		return nil, errors.New("no seat categories found")
+		return nil, apperrors.NotFound("no seat categories found")
 	}
 	return seatCategories, nil
 }
 
 // Theater
 func (s *service) AddTheater(ctx context.Context, theater Theater) error {
+	identity, err := s.rbac.Authorize(ctx, rbac.PermCreateTheater)
+	if err != nil {
+		return fmt.Errorf("unauthorized: only admins can add theaters: %w", err)
+	}
+	if !identity.IsSuperAdmin() {
+		theater.OwnerID = identity.UserID
+	}
+	if err := s.verifyOwner(ctx, theater.OwnerID); err != nil {
+		return err
+	}
 	theaterType, err := s.repo.GetTheaterTypeByID(ctx, theater.TheaterTypeID)
 	if theaterType == nil && err != nil {
-		return fmt.Errorf("theater type not exist with theater-type id %d", theater.TheaterTypeID)
+		return apperrors.NotFound("theater type not exist with theater-type id %d", theater.TheaterTypeID)
 	}
 	stateCount, err := s.repo.CountTheatersByOwnerAndState(ctx, theater.OwnerID, theater.State)
 	if err != nil {
 		return fmt.Errorf("failed to count theaters for owner in the state: %w", err)
 	}
 	if MaxTheatersPerOwnerInState <= stateCount {
-		return errors.New("the owner has reached the maximum limit of theaters in this state")
+		return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this state")
 	}
 	districtCount, err := s.repo.CountTheatersByOwnerAndDistrict(ctx, theater.OwnerID, theater.State)
 	if err != nil {
 		return fmt.Errorf("failed to count theaters for owner in the state: %w", err)
 	}
 	if MaxTheatersPerOwnerInDistrict <= districtCount {
-		return errors.New("the owner has reached the maximum limit of theaters in this district")
+		return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this district")
 	}
 	cityCount, err := s.repo.CountTheatersByOwnerAndCity(ctx, theater.OwnerID, theater.City)
 	if err != nil {
 		return fmt.Errorf("failed to count theaters for owner in the city: %w", err)
 	}
 	if MaxTheatersPerOwnerInCity <= cityCount {
-		return errors.New("the owner has reached the maximum limit of theaters in this city")
+		return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this city")
 	}
 	placeCount, err := s.repo.CountTheatersByOwnerAndPlace(ctx, theater.OwnerID, theater.Place)
 	if err != nil {
 		return fmt.Errorf("failed to count theaters for owner in the place: %w", err)
 	}
 	if MaxTheatersPerOwnerInPlace <= placeCount {
-		return errors.New("the owner has reached the maximum limit of theaters in this place")
+		return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this place")
 	}
 	res, err := s.repo.FindTheaterByNamePlaceAndCity(ctx, theater.Name, theater.Place, theater.City)
 	if err != nil && err != gorm.ErrRecordNotFound {
@@ -848,13 +942,19 @@ func (s *service) AddTheater(ctx context.Context, theater Theater) error {
 			return err
 		}
 	} else {
-		return errors.New("theater already exists")
+		return apperrors.AlreadyExists("theater already exists")
 	}
 
 	return nil
 }
 
 func (s *service) DeleteTheaterByID(ctx context.Context, id int) error {
+	if _, err := s.GetTheaterByID(ctx, id); err != nil {
+		return err
+	}
+	if err := s.authorizeTheater(ctx, id, rbac.PermManageTheater, "delete theater"); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteTheaterByID(ctx, id); err != nil {
 		return err
 	}
@@ -862,6 +962,15 @@ func (s *service) DeleteTheaterByID(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteTheaterByName(ctx context.Context, name string) error {
+	theaters, err := s.GetTheaterByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, theater := range theaters {
+		if err := s.authorizeTheater(ctx, int(theater.ID), rbac.PermManageTheater, "delete theater"); err != nil {
+			return err
+		}
+	}
 	if err := s.repo.DeleteTheaterByName(ctx, name); err != nil {
 		return err
 	}
@@ -874,7 +983,7 @@ func (s *service) GetTheaterByID(ctx context.Context, id int) (*Theater, error)
 		return nil, err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("no theater found with id %d", id)
+		return nil, apperrors.NotFound("no theater found with id %d", id)
 	}
 	return theater, nil
 }
@@ -885,7 +994,7 @@ func (s *service) GetTheaterByName(ctx context.Context, name string) ([]Theater,
 		return nil, err
 	}
 	if len(theaters) < 1 {
-		return nil, fmt.Errorf("no theaters with name %s", name)
+		return nil, apperrors.NotFound("no theaters with name %s", name)
 	}
 	return theaters, nil
 }
@@ -896,8 +1005,8 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 		return fmt.Errorf("failed to find theater: %w", err)
 	}
 
-	if theater.OwnerID != input.OwnerID {
-		return fmt.Errorf("unauthorized: only the theater's admin can update this theater")
+	if err := s.authorizeTheater(ctx, int(theater.ID), rbac.PermManageTheater, "update theater"); err != nil {
+		return err
 	}
 
 	if input.Name != "" && input.Place != "" && input.City != "" {
@@ -906,7 +1015,7 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 			return err
 		}
 		if existingTheater != nil && existingTheater.ID != theater.ID {
-			return errors.New("another theater with the same name and place already exists")
+			return apperrors.AlreadyExists("another theater with the same name and place already exists")
 		}
 	}
 
@@ -916,7 +1025,7 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 			return fmt.Errorf("failed to count theaters for owner in the state: %w", err)
 		}
 		if MaxTheatersPerOwnerInState <= stateCount {
-			return errors.New("the owner has reached the maximum limit of theaters in this state")
+			return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this state")
 		}
 	}
 
@@ -926,7 +1035,7 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 			return fmt.Errorf("failed to count theaters for owner in the district: %w", err)
 		}
 		if MaxTheatersPerOwnerInDistrict <= districtCount {
-			return errors.New("the owner has reached the maximum limit of theaters in this district")
+			return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this district")
 		}
 	}
 
@@ -936,7 +1045,7 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 			return fmt.Errorf("failed to count theaters for owner in the city: %w", err)
 		}
 		if MaxTheatersPerOwnerInCity <= cityCount {
-			return errors.New("the owner has reached the maximum limit of theaters in this city")
+			return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this city")
 		}
 	}
 
@@ -946,13 +1055,13 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 			return fmt.Errorf("failed to count theaters for owner in the place: %w", err)
 		}
 		if MaxTheatersPerOwnerInPlace <= placeCount {
-			return errors.New("the owner has reached the maximum limit of theaters in this place")
+			return apperrors.FailedPrecondition("the owner has reached the maximum limit of theaters in this place")
 		}
 	}
 	if input.TheaterTypeID != 0 {
 		theaterType, err := s.repo.GetTheaterTypeByID(ctx, input.TheaterTypeID)
 		if err != nil {
-			return fmt.Errorf("invalid theater type: %w", err)
+			return apperrors.InvalidArgument("invalid theater type: %w", err)
 		}
 		theater.TheaterTypeID = input.TheaterTypeID
 		theater.TheaterType = *theaterType
@@ -960,7 +1069,7 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 
 	if input.NumberOfScreens != 0 {
 		if input.NumberOfScreens > MaxScreenPerTheater {
-			return errors.New("the number of screens exceeds the allowed limit for this theater")
+			return apperrors.FailedPrecondition("the number of screens exceeds the allowed limit for this theater")
 		}
 		theater.NumberOfScreens = input.NumberOfScreens
 	}
@@ -987,18 +1096,18 @@ func (s *service) UpdateTheater(ctx context.Context, theaterID uint, input Theat
 
 	return nil
 }
-func (s *service) ListTheaters(ctx context.Context) ([]TheaterWithTypeResponse, error) {
-	theaters, err := s.repo.ListTheaters(ctx)
+func (s *service) ListTheaters(ctx context.Context, page pagination.Request) ([]TheaterWithTypeResponse, string, error) {
+	theaters, err := s.repo.ListTheaters(ctx, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(theaters) < 1 {
-		return nil, errors.New("no theaters found")
+	if len(theaters.Items) < 1 {
+		return nil, "", apperrors.NotFound("no theaters found")
 	}
 
 	theaterResponses := []TheaterWithTypeResponse{}
 
-	for _, theater := range theaters {
+	for _, theater := range theaters.Items {
 		theaterResponse := TheaterWithTypeResponse{
 			ID:              int(theater.ID),
 			Name:            theater.Name,
@@ -1016,37 +1125,37 @@ func (s *service) ListTheaters(ctx context.Context) ([]TheaterWithTypeResponse,
 		theaterResponses = append(theaterResponses, theaterResponse)
 	}
 
-	return theaterResponses, nil
+	return theaterResponses, theaters.NextPageToken, nil
 }
 
 // Theater Screens
-func (s *service) AddTheaterScreen(ctx context.Context, theaterScreen TheaterScreen, ownerId int) error {
+func (s *service) AddTheaterScreen(ctx context.Context, theaterScreen TheaterScreen) error {
 	theater, err := s.repo.GetTheaterByID(ctx, theaterScreen.TheaterID)
 	if theater == nil && err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("theater not exist with theater id %d", theaterScreen.TheaterID)
+		return apperrors.NotFound("theater not exist with theater id %d", theaterScreen.TheaterID)
 	}
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return err
 	}
-	if theater.OwnerID != uint(ownerId) {
-		return fmt.Errorf("unauthorized: only the theater's admin can add theater screen in this theater")
+	if err := s.authorizeTheater(ctx, int(theater.ID), rbac.PermManageTheater, "add theater screen"); err != nil {
+		return err
 	}
 	screen, err := s.repo.GetScreenTypeByID(ctx, theaterScreen.ScreenTypeID)
 	if screen == nil && err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("sceen type not exist with screen type id %d", theaterScreen.ScreenTypeID)
+		return apperrors.NotFound("sceen type not exist with screen type id %d", theaterScreen.ScreenTypeID)
 	}
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return err
 	}
 	res, err := s.repo.FindTheaterScreenByTheaterIDAndScreenNumber(ctx, theaterScreen.TheaterID, theaterScreen.ScreenNumber)
 	if res != nil && err == nil {
-		return errors.New("theater screen already exists")
+		return apperrors.AlreadyExists("theater screen already exists")
 	}
 	if err != gorm.ErrRecordNotFound {
 		return err
 	}
 	if MaxScreenPerTheater < theaterScreen.ScreenNumber {
-		return errors.New("the theater has reached the maximum screen limit")
+		return apperrors.FailedPrecondition("the theater has reached the maximum screen limit")
 	}
 	if err := s.repo.CreateTheaterScreen(ctx, theaterScreen); err != nil {
 		return err
@@ -1055,6 +1164,9 @@ func (s *service) AddTheaterScreen(ctx context.Context, theaterScreen TheaterScr
 }
 
 func (s *service) DeleteTheaterScreenByID(ctx context.Context, id int) error {
+	if err := s.authorizeScreen(ctx, id, rbac.PermManageTheater, "delete theater screen"); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteTheaterScreenByID(ctx, id); err != nil {
 		return err
 	}
@@ -1062,6 +1174,9 @@ func (s *service) DeleteTheaterScreenByID(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteTheaterScreenByNumber(ctx context.Context, theaterID int, screenNumber int) error {
+	if err := s.authorizeTheater(ctx, theaterID, rbac.PermManageTheater, "delete theater screen"); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteTheaterScreenByNumber(ctx, theaterID, screenNumber); err != nil {
 		return err
 	}
@@ -1084,16 +1199,21 @@ func (s *service) GetTheaterScreenByNumber(ctx context.Context, theaterID int, s
 	return theaterScreen, nil
 }
 
-func (s *service) UpdateTheaterScreen(ctx context.Context, id int, theaterScreen TheaterScreen, ownerId int) error {
+func (s *service) UpdateTheaterScreen(ctx context.Context, id int, theaterScreen TheaterScreen) error {
 	res, err := s.repo.GetTheaterScreenByID(ctx, id)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return err
 	}
 	if err != nil && err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("theater screen not found with id %d", id)
+		return apperrors.NotFound("theater screen not found with id %d", id)
 	}
-	if res.Theater.OwnerID != uint(ownerId) {
-		return fmt.Errorf("unauthorized: only the theater's admin can update this theater screen")
+	if err := s.authorizeTheater(ctx, res.TheaterID, rbac.PermManageTheater, "update theater screen"); err != nil {
+		return err
+	}
+	if theaterScreen.TheaterID != 0 && theaterScreen.TheaterID != res.TheaterID {
+		if err := s.authorizeTheater(ctx, theaterScreen.TheaterID, rbac.PermManageTheater, "move theater screen"); err != nil {
+			return err
+		}
 	}
 	err = s.repo.UpdateTheaterScreen(ctx, id, theaterScreen)
 	if err != nil {
@@ -1108,45 +1228,136 @@ func (s *service) ListTheaterScreens(ctx context.Context, theaterId int) ([]Thea
 		return nil, err
 	}
 	if len(theaterScreens) < 1 {
-		return nil, errors.New("no theater screens found")
+		return nil, apperrors.NotFound("no theater screens found")
 	}
 	return theaterScreens, nil
 }
 
 // Showtimes
-func (s *service) AddShowtime(ctx context.Context, showtime Showtime, ownerId int) error {
-	movie, err := s.movieRepo.GetMovieDetailsById(ctx, showtime.MovieID)
-	if movie == nil && err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("movie not exist with id %d", showtime.MovieID)
+
+// resolveVersion returns the version a showtime of movieId shows: versionId
+// when given, which must be a version of the movie, or else the movie's
+// default version. movieId may be zero when versionId is set.
+func (s *service) resolveVersion(ctx context.Context, movieId, versionId int) (*movies.MovieVersion, error) {
+	if versionId == 0 {
+		if _, err := s.movieRepo.GetMovieDetailsById(ctx, movieId); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, apperrors.NotFound("movie not exist with id %d", movieId)
+			}
+			return nil, err
+		}
+		version, err := s.movieRepo.GetDefaultMovieVersion(ctx, movieId)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, apperrors.FailedPrecondition("movie %d has no versions to schedule", movieId)
+			}
+			return nil, err
+		}
+		return version, nil
 	}
-	if err != nil && err != gorm.ErrRecordNotFound {
+	version, err := s.movieRepo.GetMovieVersionByID(ctx, versionId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("movie version not exist with id %d", versionId)
+		}
+		return nil, err
+	}
+	if movieId != 0 && int(version.MovieID) != movieId {
+		return nil, apperrors.InvalidArgument("movie version %d is not a version of movie %d", versionId, movieId)
+	}
+	return version, nil
+}
+
+// checkScreenFormat fails unless the screen's type can project the version.
+func (s *service) checkScreenFormat(ctx context.Context, screen *TheaterScreen, version *movies.MovieVersion) error {
+	formats, err := s.screenTypeFormats(ctx, screen.ScreenTypeID)
+	if err != nil {
 		return err
 	}
+	if !slices.Contains(formats, version.Format) {
+		return apperrors.FailedPrecondition("screen %d cannot show %s, it supports %s", screen.ID, version.Format, strings.Join(formats, ", "))
+	}
+	return nil
+}
+
+func (s *service) screenTypeFormats(ctx context.Context, screenTypeId int) ([]string, error) {
+	formats, err := s.repo.GetScreenTypeFormats(ctx, screenTypeId)
+	if err != nil {
+		return nil, err
+	}
+	if len(formats) == 0 {
+		return []string{movies.Format2D}, nil
+	}
+	return formats, nil
+}
+
+// AddShowtime schedules a movie version on a screen and returns the new
+// showtime's ID. Without a version the movie's default version is used.
+func (s *service) AddShowtime(ctx context.Context, showtime Showtime) (int, error) {
+	version, err := s.resolveVersion(ctx, showtime.MovieID, showtime.MovieVersionID)
+	if err != nil {
+		return 0, err
+	}
+	showtime.MovieID = int(version.MovieID)
+	showtime.MovieVersionID = int(version.ID)
 	theaterScreen, err := s.repo.GetTheaterScreenByID(ctx, showtime.ScreenID)
 	if theaterScreen == nil && err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("screen not exist with id %d", showtime.ScreenID)
+		return 0, apperrors.NotFound("screen not exist with id %d", showtime.ScreenID)
 	}
 	if err != nil && err != gorm.ErrRecordNotFound {
-		return err
+		return 0, err
+	}
+	if err := s.authorizeTheater(ctx, theaterScreen.TheaterID, rbac.PermManageShows, "add show time"); err != nil {
+		return 0, err
 	}
-	if theaterScreen.Theater.OwnerID != uint(ownerId) {
-		return fmt.Errorf("unauthorized: only the theater's admin can add this show time")
+	if err := s.checkScreenFormat(ctx, theaterScreen, version); err != nil {
+		return 0, err
 	}
 	res, err := s.repo.FindShowtimeByDetails(ctx, showtime.MovieID, showtime.ScreenID, showtime.ShowDate, showtime.ShowTime)
 	if res != nil && err == nil {
-		return errors.New("showtime already exists")
+		return 0, apperrors.AlreadyExists("showtime already exists")
 	}
 	if err != gorm.ErrRecordNotFound {
-		return err
+		return 0, err
 	}
 
-	if err := s.repo.CreateShowtime(ctx, showtime); err != nil {
-		return err
+	return s.repo.CreateShowtime(ctx, showtime)
+}
+
+func (s *service) SetShowtimeVersion(ctx context.Context, id int, versionId int) error {
+	if versionId == 0 {
+		return apperrors.InvalidArgument("movie version id is required")
 	}
-	return nil
+	return s.UpdateShowtime(ctx, id, Showtime{MovieVersionID: versionId})
+}
+
+func (s *service) GetShowtimeWithVersion(ctx context.Context, id int) (*Showtime, *movies.MovieVersion, error) {
+	showtime, err := s.repo.GetShowtimeByID(ctx, id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil, apperrors.NotFound("show time not found with id %d", id)
+		}
+		return nil, nil, err
+	}
+	// The version is nil once it has been deleted from the catalog.
+	version, err := s.movieRepo.GetMovieVersionByID(ctx, showtime.MovieVersionID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, nil, err
+	}
+	return showtime, version, nil
 }
 
 func (s *service) DeleteShowtimeByID(ctx context.Context, id int) error {
+	showtime, err := s.repo.GetShowtimeByID(ctx, id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("show time not found with id %d", id)
+		}
+		return err
+	}
+	if err := s.authorizeTheater(ctx, showtime.TheaterScreen.TheaterID, rbac.PermManageShows, "delete show time"); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteShowtimeByID(ctx, id); err != nil {
 		return err
 	}
@@ -1154,6 +1365,9 @@ func (s *service) DeleteShowtimeByID(ctx context.Context, id int) error {
 }
 
 func (s *service) DeleteShowtimeByDetails(ctx context.Context, movieID int, screenID int, showDate time.Time, showTime time.Time) error {
+	if err := s.authorizeScreen(ctx, screenID, rbac.PermManageShows, "delete show time"); err != nil {
+		return err
+	}
 	if err := s.repo.DeleteShowtimeByDetails(ctx, movieID, screenID, showDate, showTime); err != nil {
 		return err
 	}
@@ -1176,24 +1390,53 @@ func (s *service) GetShowtimeByDetails(ctx context.Context, movieID int, screenI
 	return showtime, nil
 }
 
-func (s *service) UpdateShowtime(ctx context.Context, id int, showtime Showtime, ownerId int) error {
+func (s *service) UpdateShowtime(ctx context.Context, id int, showtime Showtime) error {
 	res, err := s.repo.GetShowtimeByID(ctx, id)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("show time not found with id %d", id)
+		return apperrors.NotFound("show time not found with id %d", id)
 	}
 	theater, err := s.repo.GetTheaterByID(ctx, res.TheaterScreen.TheaterID)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return err
 	}
 	if err == gorm.ErrRecordNotFound {
-		return fmt.Errorf("theater not exist with id %d", res.TheaterScreen.TheaterID)
+		return apperrors.NotFound("theater not exist with id %d", res.TheaterScreen.TheaterID)
+	}
+	if err := s.authorizeTheater(ctx, int(theater.ID), rbac.PermManageShows, "update show time"); err != nil {
+		return err
+	}
+	screen := &res.TheaterScreen
+	if showtime.ScreenID != 0 && showtime.ScreenID != res.ScreenID {
+		screen, err = s.repo.GetTheaterScreenByID(ctx, showtime.ScreenID)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return apperrors.NotFound("screen not exist with id %d", showtime.ScreenID)
+			}
+			return err
+		}
+		if err := s.authorizeTheater(ctx, screen.TheaterID, rbac.PermManageShows, "move show time"); err != nil {
+			return err
+		}
 	}
-	if theater.OwnerID != uint(ownerId) {
-		return fmt.Errorf("unauthorized: only the theater's admin can update this show time")
+	// A new movie without a version gets its default version.
+	movieId, versionId := res.MovieID, showtime.MovieVersionID
+	if showtime.MovieID != 0 {
+		movieId = showtime.MovieID
 	}
+	if versionId == 0 && movieId == res.MovieID {
+		versionId = res.MovieVersionID
+	}
+	version, err := s.resolveVersion(ctx, movieId, versionId)
+	if err != nil {
+		return err
+	}
+	if err := s.checkScreenFormat(ctx, screen, version); err != nil {
+		return err
+	}
+	showtime.MovieVersionID = int(version.ID)
 	err = s.repo.UpdateShowtime(ctx, id, showtime)
 	if err != nil {
 		return err
@@ -1201,42 +1444,107 @@ func (s *service) UpdateShowtime(ctx context.Context, id int, showtime Showtime,
 	return nil
 }
 
-func (s *service) ListShowtimes(ctx context.Context, movieID int) ([]Showtime, error) {
-	showtimes, err := s.repo.ListShowtimes(ctx, movieID)
+func (s *service) ListShowtimes(ctx context.Context, movieID int, page pagination.Request) ([]Showtime, string, error) {
+	showtimes, err := s.repo.ListShowtimes(ctx, movieID, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if len(showtimes) < 1 {
-		return nil, errors.New("no showtimes found")
+	if len(showtimes.Items) < 1 {
+		return nil, "", apperrors.NotFound("no showtimes found")
 	}
-	return showtimes, nil
+	return showtimes.Items, showtimes.NextPageToken, nil
 }
-func (s *service) ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int) ([]Showtime, error) {
+func (s *service) ListShowtimesByShowDateAndMovieID(ctx context.Context, showDate time.Time, movieId int, page pagination.Request) ([]Showtime, string, error) {
 	movie, err := s.movieRepo.GetMovieDetailsById(ctx, movieId)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("movie not found with id %d, movie id is invalid", movieId)
+			return nil, "", apperrors.NotFound("movie not found with id %d, movie id is invalid", movieId)
 		}
-		return nil, err
+		return nil, "", err
 	}
 
-	showtimes, err := s.repo.ListShowtimesByShowDateAndMovieID(ctx, showDate, int(movie.ID))
+	result, err := s.repo.ListShowtimesByShowDateAndMovieID(ctx, showDate, int(movie.ID), page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	if len(result.Items) == 0 {
+		return nil, "", apperrors.NotFound("no showtimes found with movie id %d on date %v", movieId, showDate)
+	}
+	return result.Items, result.NextPageToken, nil
+}
 
-	if len(showtimes) == 0 {
-		return nil, fmt.Errorf("no showtimes found with movie id %d on date %v", movieId, showDate)
+// verifyOwner checks that ownerID is a theater admin the super admin has
+// approved in the user-admin service.
+func (s *service) verifyOwner(ctx context.Context, ownerID uint) error {
+	admin, err := s.users.GetAdmin(ctx, int(ownerID))
+	if status.Code(err) == codes.NotFound {
+		return apperrors.FailedPrecondition("owner %d is not a theater admin", ownerID)
 	}
+	if err != nil {
+		return fmt.Errorf("failed to look up theater admin %d: %w", ownerID, err)
+	}
+	if !admin.GetIsVerified() {
+		return apperrors.FailedPrecondition("theater admin %d has not been approved", ownerID)
+	}
+	return nil
+}
 
-	for i := range showtimes {
-		theater, err := s.repo.GetTheaterByID(ctx, showtimes[i].TheaterScreen.TheaterID)
-		if err != nil {
-			return nil, err
+// authorizeTheater checks that the caller holds permission on the theater.
+func (s *service) authorizeTheater(ctx context.Context, theaterId int, permission rbac.Permission, action string) error {
+	if _, err := s.rbac.AuthorizeTheater(ctx, uint(theaterId), permission); err != nil {
+		return fmt.Errorf("unauthorized: cannot %s in theater %d: %w", action, theaterId, err)
+	}
+	return nil
+}
+
+func (s *service) authorizeScreen(ctx context.Context, screenId int, permission rbac.Permission, action string) error {
+	screen, err := s.repo.GetTheaterScreenByID(ctx, screenId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("theater screen not found with id %d", screenId)
 		}
+		return err
+	}
+	return s.authorizeTheater(ctx, screen.TheaterID, permission, action)
+}
+
+// Theater, screen types and seat categories are shared by every theater and
+// only super admins may change them.
+func (s *service) authorizeCatalog(ctx context.Context) error {
+	if _, err := s.rbac.Authorize(ctx, rbac.PermManageCatalog); err != nil {
+		return fmt.Errorf("unauthorized: only super admins can change theater, screen types and seat categories: %w", err)
+	}
+	return nil
+}
 
-		showtimes[i].TheaterScreen.Theater = *theater
+func (s *service) GetScreenTypeFormats(ctx context.Context, screenTypeId int) ([]string, error) {
+	if _, err := s.repo.GetScreenTypeByID(ctx, screenTypeId); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("screen type not found with id %d", screenTypeId)
+		}
+		return nil, err
 	}
+	return s.screenTypeFormats(ctx, screenTypeId)
+}
 
-	return showtimes, nil
+func (s *service) SetScreenTypeFormats(ctx context.Context, screenTypeId int, formats []string) error {
+	if err := s.authorizeCatalog(ctx); err != nil {
+		return err
+	}
+	unique := []string{}
+	for _, format := range formats {
+		if !slices.Contains(movies.Formats, format) {
+			return apperrors.InvalidArgument("invalid format %q", format)
+		}
+		if !slices.Contains(unique, format) {
+			unique = append(unique, format)
+		}
+	}
+	if _, err := s.repo.GetScreenTypeByID(ctx, screenTypeId); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return apperrors.NotFound("screen type not found with id %d", screenTypeId)
+		}
+		return err
+	}
+	return s.repo.SetScreenTypeFormats(ctx, screenTypeId, unique)
 }
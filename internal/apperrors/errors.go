@@ -0,0 +1,126 @@
+// Package apperrors defines the typed errors returned by the services and
+// their mapping to gRPC status codes.
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindAlreadyExists
+	KindPermissionDenied
+	KindUnauthenticated
+	KindFailedPrecondition
+	KindConflict
+)
+
+var kinds = map[Kind]struct {
+	code   codes.Code
+	reason string
+}{
+	KindUnknown:            {codes.Unknown, "UNKNOWN"},
+	KindInvalidArgument:    {codes.InvalidArgument, "INVALID_ARGUMENT"},
+	KindNotFound:           {codes.NotFound, "NOT_FOUND"},
+	KindAlreadyExists:      {codes.AlreadyExists, "ALREADY_EXISTS"},
+	KindPermissionDenied:   {codes.PermissionDenied, "PERMISSION_DENIED"},
+	KindUnauthenticated:    {codes.Unauthenticated, "UNAUTHENTICATED"},
+	KindFailedPrecondition: {codes.FailedPrecondition, "FAILED_PRECONDITION"},
+	KindConflict:           {codes.Aborted, "CONFLICT"},
+}
+
+func (k Kind) Code() codes.Code {
+	return kinds[k].code
+}
+
+func (k Kind) String() string {
+	return kinds[k].reason
+}
+
+type Error struct {
+	Kind    Kind
+	Message string
+	// Metadata is sent to clients in the ErrorInfo detail.
+	Metadata map[string]string
+	err      error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// WithMetadata returns a copy of the error carrying the given key/value pair.
+func (e *Error) WithMetadata(key, value string) *Error {
+	cp := *e
+	cp.Metadata = make(map[string]string, len(e.Metadata)+1)
+	for k, v := range e.Metadata {
+		cp.Metadata[k] = v
+	}
+	cp.Metadata[key] = value
+	return &cp
+}
+
+// newError formats like fmt.Errorf, so %w keeps the wrapped error reachable
+// through errors.Is and errors.As.
+func newError(kind Kind, format string, args ...interface{}) *Error {
+	err := fmt.Errorf(format, args...)
+	return &Error{
+		Kind:    kind,
+		Message: err.Error(),
+		err:     errors.Unwrap(err),
+	}
+}
+
+func InvalidArgument(format string, args ...interface{}) *Error {
+	return newError(KindInvalidArgument, format, args...)
+}
+
+func NotFound(format string, args ...interface{}) *Error {
+	return newError(KindNotFound, format, args...)
+}
+
+func AlreadyExists(format string, args ...interface{}) *Error {
+	return newError(KindAlreadyExists, format, args...)
+}
+
+func PermissionDenied(format string, args ...interface{}) *Error {
+	return newError(KindPermissionDenied, format, args...)
+}
+
+func Unauthenticated(format string, args ...interface{}) *Error {
+	return newError(KindUnauthenticated, format, args...)
+}
+
+func FailedPrecondition(format string, args ...interface{}) *Error {
+	return newError(KindFailedPrecondition, format, args...)
+}
+
+// Conflict reports a write that lost against a concurrent change, e.g. seats
+// booked by someone else. Clients may retry with fresh data.
+func Conflict(format string, args ...interface{}) *Error {
+	return newError(KindConflict, format, args...)
+}
+
+// KindOf returns the kind of the first typed error in err's chain.
+func KindOf(err error) Kind {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Kind
+	}
+	return KindUnknown
+}
+
+func Is(err error, kind Kind) bool {
+	return KindOf(err) == kind
+}
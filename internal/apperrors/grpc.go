@@ -0,0 +1,80 @@
+package apperrors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const Domain = "movies-booking-svc"
+
+// internalMessage replaces the message of untyped errors, which may carry
+// SQL, hostnames or other details clients must not see.
+const internalMessage = "internal error"
+
+// ToStatus converts err into a gRPC status. Errors that already are statuses
+// are returned as they are; untyped errors become Internal.
+func ToStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	var appErr *Error
+	if !errors.As(err, &appErr) {
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			return status.New(codes.DeadlineExceeded, err.Error())
+		case errors.Is(err, context.Canceled):
+			return status.New(codes.Canceled, err.Error())
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			appErr = &Error{Kind: KindNotFound}
+		default:
+			return status.New(codes.Internal, internalMessage)
+		}
+	}
+	st := status.New(appErr.Kind.Code(), err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   appErr.Kind.String(),
+		Domain:   Domain,
+		Metadata: appErr.Metadata,
+	})
+	if detailErr != nil {
+		return st
+	}
+	return detailed
+}
+
+// toStatus is ToStatus for the interceptors. It keeps the message hidden
+// from the client on the request's access log line.
+func toStatus(ctx context.Context, err error) *status.Status {
+	st := ToStatus(err)
+	if _, ok := status.FromError(err); !ok && st.Code() == codes.Internal {
+		logger.With(ctx, "cause", err.Error())
+	}
+	return st
+}
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatus(ctx, err).Err()
+		}
+		return resp, nil
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return toStatus(stream.Context(), err).Err()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package apperrors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// leaky is an error whose message must not reach clients.
+var leaky = errors.New(`pq: relation "bookings" does not exist at db.internal:5432`)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+		wantMsg    string
+	}{
+		{"invalid argument", InvalidArgument("bad seat %s", "Z9"), codes.InvalidArgument, "INVALID_ARGUMENT", "bad seat Z9"},
+		{"not found", NotFound("no booking %d", 1), codes.NotFound, "NOT_FOUND", "no booking 1"},
+		{"already exists", AlreadyExists("taken"), codes.AlreadyExists, "ALREADY_EXISTS", "taken"},
+		{"permission denied", PermissionDenied("denied"), codes.PermissionDenied, "PERMISSION_DENIED", "denied"},
+		{"unauthenticated", Unauthenticated("who are you"), codes.Unauthenticated, "UNAUTHENTICATED", "who are you"},
+		{"failed precondition", FailedPrecondition("not paid"), codes.FailedPrecondition, "FAILED_PRECONDITION", "not paid"},
+		{"conflict", Conflict("seats gone"), codes.Aborted, "CONFLICT", "seats gone"},
+		{"wrapped", fmt.Errorf("booking 3: %w", NotFound("no seats")), codes.NotFound, "NOT_FOUND", "booking 3: no seats"},
+		{"record not found", fmt.Errorf("load theater: %w", gorm.ErrRecordNotFound), codes.NotFound, "NOT_FOUND", "load theater: record not found"},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded, "", "query: context deadline exceeded"},
+		{"canceled", context.Canceled, codes.Canceled, "", "context canceled"},
+		{"status", status.Error(codes.Unavailable, "payment is down"), codes.Unavailable, "", "payment is down"},
+		{"unknown", leaky, codes.Internal, "", internalMessage},
+		{"wrapped unknown", fmt.Errorf("create booking: %w", leaky), codes.Internal, "", internalMessage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := ToStatus(tt.err)
+			if st.Code() != tt.wantCode || st.Message() != tt.wantMsg {
+				t.Fatalf("ToStatus = %v %q, want %v %q", st.Code(), st.Message(), tt.wantCode, tt.wantMsg)
+			}
+			var reason string
+			for _, detail := range st.Details() {
+				if info, ok := detail.(*errdetails.ErrorInfo); ok {
+					reason = info.Reason
+					if info.Domain != Domain {
+						t.Errorf("domain = %q, want %q", info.Domain, Domain)
+					}
+				}
+			}
+			if reason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestToStatusMetadata(t *testing.T) {
+	err := Conflict("seats gone").WithMetadata("seats", "A1,A2")
+	for _, detail := range ToStatus(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Metadata["seats"] == "A1,A2" {
+			return
+		}
+	}
+	t.Fatal("ErrorInfo does not carry the error's metadata")
+}
+
+// callWithLog runs the apperrors interceptor inside the logging interceptor,
+// as the server chains them. It returns the access log and the handler's
+// error as the client sees it.
+func callWithLog(t *testing.T, handlerErr error) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	log := slog.New(slog.NewTextHandler(&buf, nil))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, handlerErr
+	}
+	inner := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return UnaryServerInterceptor()(ctx, req, info, handler)
+	}
+	_, err := logger.UnaryServerInterceptor(log)(context.Background(), nil, info, inner)
+	return buf.String(), err
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	_, err := callWithLog(t, fmt.Errorf("cancel booking: %w", FailedPrecondition("already cancelled")))
+	if st, _ := status.FromError(err); st.Code() != codes.FailedPrecondition || st.Message() != "cancel booking: already cancelled" {
+		t.Fatalf("typed error = %v, want failed precondition", err)
+	}
+
+	log, err := callWithLog(t, fmt.Errorf("create booking: %w", leaky))
+	st, _ := status.FromError(err)
+	if st.Code() != codes.Internal || st.Message() != internalMessage {
+		t.Fatalf("unknown error = %v, want %v %q", err, codes.Internal, internalMessage)
+	}
+	if strings.Contains(err.Error(), "pq:") || len(st.Details()) != 0 {
+		t.Fatalf("client status leaks the cause: %v %v", err, st.Details())
+	}
+	// The cause is kept for operators on the access log line.
+	if !strings.Contains(log, "db.internal:5432") {
+		t.Fatalf("access log lacks the cause: %s", log)
+	}
+
+	if _, err := callWithLog(t, nil); err != nil {
+		t.Fatalf("success = %v, want nil", err)
+	}
+}
+
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s stream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/test/Stream"}
+	for _, tt := range []struct {
+		err  error
+		want codes.Code
+	}{
+		{NotFound("no showtime"), codes.NotFound},
+		{leaky, codes.Internal},
+		{nil, codes.OK},
+	} {
+		err := StreamServerInterceptor()(nil, stream{ctx: context.Background()}, info, func(interface{}, grpc.ServerStream) error {
+			return tt.err
+		})
+		if status.Code(err) != tt.want {
+			t.Errorf("stream error %v = %v, want %v", tt.err, err, tt.want)
+		}
+		if err != nil && strings.Contains(err.Error(), "pq:") {
+			t.Errorf("stream status leaks the cause: %v", err)
+		}
+	}
+}
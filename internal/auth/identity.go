@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+)
+
+type Role string
+
+const (
+	RoleUser       Role = "user"
+	RoleAdmin      Role = "admin"
+	RoleSuperAdmin Role = "super-admin"
+	// RoleService is used by other backend services such as payment.
+	RoleService Role = "service"
+)
+
+var (
+	ErrUnauthenticated  error = apperrors.Unauthenticated("unauthenticated: caller identity is missing")
+	ErrPermissionDenied error = apperrors.PermissionDenied("permission denied")
+)
+
+type Identity struct {
+	UserID uint
+	Role   Role
+	// Peer is the name in the client certificate when the call came over
+	// mTLS.
+	Peer string
+}
+
+func (i Identity) IsSuperAdmin() bool {
+	return i.Role == RoleSuperAdmin
+}
+
+func (i Identity) IsAdmin() bool {
+	return i.Role == RoleAdmin || i.Role == RoleSuperAdmin
+}
+
+func (i Identity) IsService() bool {
+	return i.Role == RoleService
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the caller identity, or ErrUnauthenticated when the
+// request carried none.
+func FromContext(ctx context.Context) (Identity, error) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	if !ok {
+		return Identity{}, ErrUnauthenticated
+	}
+	return identity, nil
+}
+
+// Users and admins are registered separately in the user-admin service, so
+// their ids overlap and ownership checks must compare the role as well.
+// Theater level permissions live in the rbac module.
+
+// RequireUser allows the user itself and super admins.
+func RequireUser(ctx context.Context, userId uint) (Identity, error) {
+	identity, err := FromContext(ctx)
+	if err != nil {
+		return identity, err
+	}
+	if identity.IsSuperAdmin() || (identity.Role == RoleUser && identity.UserID == userId) {
+		return identity, nil
+	}
+	return identity, ErrPermissionDenied
+}
+
+// RequireRole allows any of the given roles and super admins.
+func RequireRole(ctx context.Context, roles ...Role) (Identity, error) {
+	identity, err := FromContext(ctx)
+	if err != nil {
+		return identity, err
+	}
+	if identity.IsSuperAdmin() {
+		return identity, nil
+	}
+	for _, role := range roles {
+		if identity.Role == role {
+			return identity, nil
+		}
+	}
+	return identity, ErrPermissionDenied
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator verifies the credentials of an incoming request. It returns
+// nil claims when the request carries no credentials.
+type Authenticator interface {
+	Authenticate(ctx context.Context) (*Claims, error)
+}
+
+type Policy int
+
+const (
+	// PolicyAuthenticated is the default for methods without a policy.
+	PolicyAuthenticated Policy = iota
+	PolicyPublic
+	// PolicyAdmin only admits super admins.
+	PolicyAdmin
+	// PolicyTheaterOwner admits theater admins; the services check that the
+	// caller owns the theater being changed.
+	PolicyTheaterOwner
+)
+
+type Interceptor struct {
+	authenticator Authenticator
+	policies      map[string]Policy
+	trustedPeers  map[string]bool
+}
+
+// NewInterceptor builds the auth interceptor. Policies are keyed by the full
+// gRPC method name, e.g. "/moviebooking.MovieService/ListMovies".
+// Callers presenting a client certificate for one of trustedPeers are
+// treated as backend services without needing a token.
+func NewInterceptor(authenticator Authenticator, policies map[string]Policy, trustedPeers []string) *Interceptor {
+	trusted := map[string]bool{}
+	for _, name := range trustedPeers {
+		trusted[name] = true
+	}
+	return &Interceptor{
+		authenticator: authenticator,
+		policies:      policies,
+		trustedPeers:  trusted,
+	}
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func (i *Interceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	policy := i.policies[method]
+	peerName, hasPeer := PeerName(ctx)
+	if hasPeer {
+		ctx = logger.With(ctx, "peer", peerName)
+	}
+	claims, err := i.authenticator.Authenticate(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	var identity Identity
+	switch {
+	case claims != nil:
+		identity, err = claims.Identity()
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		identity.Peer = peerName
+	case hasPeer && i.trustedPeers[peerName]:
+		identity = Identity{Role: RoleService, Peer: peerName}
+	case policy == PolicyPublic:
+		return ctx, nil
+	default:
+		return nil, status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+	ctx = logger.With(ctx, "user_id", identity.UserID, "role", string(identity.Role))
+	switch {
+	case policy == PolicyAdmin && !identity.IsSuperAdmin():
+		return nil, status.Errorf(codes.PermissionDenied, "%s requires a super admin", method)
+	case policy == PolicyTheaterOwner && !identity.IsAdmin():
+		return nil, status.Errorf(codes.PermissionDenied, "%s requires a theater admin", method)
+	}
+	if claims != nil {
+		ctx = WithClaims(ctx, claims)
+	}
+	return WithIdentity(ctx, identity), nil
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	publicMethod  = "/test.Service/Public"
+	defaultMethod = "/test.Service/Default"
+	adminMethod   = "/test.Service/Admin"
+	ownerMethod   = "/test.Service/Owner"
+)
+
+var testPolicies = map[string]Policy{
+	publicMethod: PolicyPublic,
+	adminMethod:  PolicyAdmin,
+	ownerMethod:  PolicyTheaterOwner,
+}
+
+func withToken(t *testing.T, role string) context.Context {
+	t.Helper()
+	if role == "" {
+		return context.Background()
+	}
+	claims := validClaims()
+	claims.RoleID = 0
+	claims.Role = role
+	token := sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claims)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestInterceptorPolicies(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(JWTConfig{HMACSecret: []byte(testSecret)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unary := NewInterceptor(authenticator, testPolicies, nil).Unary()
+
+	tests := []struct {
+		method string
+		role   string
+		want   codes.Code
+	}{
+		{publicMethod, "", codes.OK},
+		{publicMethod, "user", codes.OK},
+		{defaultMethod, "", codes.Unauthenticated},
+		{defaultMethod, "user", codes.OK},
+		{adminMethod, "user", codes.PermissionDenied},
+		{adminMethod, "admin", codes.PermissionDenied},
+		{adminMethod, "super-admin", codes.OK},
+		{ownerMethod, "", codes.Unauthenticated},
+		{ownerMethod, "user", codes.PermissionDenied},
+		{ownerMethod, "service", codes.PermissionDenied},
+		{ownerMethod, "admin", codes.OK},
+		{ownerMethod, "super-admin", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" as "+tt.role, func(t *testing.T) {
+			var identity Identity
+			var hasIdentity bool
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				var identityErr error
+				identity, identityErr = FromContext(ctx)
+				hasIdentity = identityErr == nil
+				return nil, nil
+			}
+			_, err := unary(withToken(t, tt.role), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("got %v (%v), want %v", got, err, tt.want)
+			}
+			if tt.want == codes.OK && hasIdentity != (tt.role != "") {
+				t.Fatalf("identity %+v in context for role %q", identity, tt.role)
+			}
+			if hasIdentity && (identity.UserID != 7 || string(identity.Role) != tt.role) {
+				t.Fatalf("unexpected identity %+v", identity)
+			}
+		})
+	}
+}
+
+func TestInterceptorRejectsBadToken(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(JWTConfig{HMACSecret: []byte(testSecret)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unary := NewInterceptor(authenticator, testPolicies, nil).Unary()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer forged"))
+	// A bad token is rejected even on public methods rather than ignored.
+	_, err = unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: publicMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler called")
+		return nil, nil
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("got %v", err)
+	}
+}
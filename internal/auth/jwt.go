@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+const authorizationMetadataKey = "authorization"
+
+// Role ids as issued by the auth service in the role_id claim.
+var roleIDs = map[int]Role{
+	1: RoleUser,
+	2: RoleAdmin,
+	3: RoleSuperAdmin,
+}
+
+type Claims struct {
+	UserID int    `json:"user_id"`
+	RoleID int    `json:"role_id,omitempty"`
+	Role   string `json:"role,omitempty"`
+	Email  string `json:"email,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Identity maps the claims to the caller identity. An explicit role claim
+// takes precedence over role_id so service tokens can carry RoleService.
+func (c *Claims) Identity() (Identity, error) {
+	role := Role(c.Role)
+	if role == "" {
+		role = roleIDs[c.RoleID]
+	}
+	switch role {
+	case RoleUser, RoleAdmin, RoleSuperAdmin, RoleService:
+	default:
+		return Identity{}, fmt.Errorf("unknown role in token claims")
+	}
+	if c.UserID <= 0 {
+		return Identity{}, fmt.Errorf("missing user_id in token claims")
+	}
+	return Identity{UserID: uint(c.UserID), Role: role}, nil
+}
+
+type claimsKey struct{}
+
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+type JWTConfig struct {
+	// HMACSecret verifies HS256 tokens.
+	HMACSecret []byte
+	// RSAKeys verifies RS256 tokens, keyed by the kid header.
+	RSAKeys  map[string]*rsa.PublicKey
+	Issuer   string
+	Audience string
+}
+
+// JWTAuthenticator verifies the bearer token sent in the authorization metadata.
+type JWTAuthenticator struct {
+	config JWTConfig
+	parser *jwt.Parser
+}
+
+func NewJWTAuthenticator(config JWTConfig) (*JWTAuthenticator, error) {
+	if len(config.HMACSecret) == 0 && len(config.RSAKeys) == 0 {
+		return nil, errors.New("jwt authenticator needs an hmac secret or rsa keys")
+	}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+	return &JWTAuthenticator{
+		config: config,
+		parser: jwt.NewParser(options...),
+	}, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context) (*Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	token, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		return nil, errors.New("authorization metadata must be a bearer token")
+	}
+	return a.ParseToken(token)
+}
+
+func (a *JWTAuthenticator) ParseToken(token string) (*Claims, error) {
+	claims := &Claims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.key); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claims, nil
+}
+
+func (a *JWTAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(a.config.HMACSecret) == 0 {
+			return nil, errors.New("hmac signed tokens are not accepted")
+		}
+		return a.config.HMACSecret, nil
+	case *jwt.SigningMethodRSA:
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := a.config.RSAKeys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(a.config.RSAKeys) == 1 {
+			for _, key := range a.config.RSAKeys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+const testSecret = "test-hmac-secret"
+
+func rsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// jwks encodes the public halves of keys as a JSON Web Key Set.
+func jwks(t *testing.T, keys map[string]*rsa.PrivateKey) []byte {
+	t.Helper()
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, jsonWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func validClaims() Claims {
+	return Claims{
+		UserID: 7,
+		RoleID: 2,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "auth-svc",
+			Audience:  jwt.ClaimStrings{"movies-booking-svc"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestParseToken(t *testing.T) {
+	current, previous, unknown := rsaKey(t), rsaKey(t), rsaKey(t)
+	keys, err := ParseJWKS(jwks(t, map[string]*rsa.PrivateKey{"current": current, "previous": previous}))
+	if err != nil {
+		t.Fatalf("ParseJWKS: %v", err)
+	}
+	authenticator, err := NewJWTAuthenticator(JWTConfig{
+		HMACSecret: []byte(testSecret),
+		RSAKeys:    keys,
+		Issuer:     "auth-svc",
+		Audience:   "movies-booking-svc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+	otherIssuer := validClaims()
+	otherIssuer.Issuer = "someone-else"
+	otherAudience := validClaims()
+	otherAudience.Audience = jwt.ClaimStrings{"payment-svc"}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"hs256", sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims()), ""},
+		{"rs256 current key", sign(t, jwt.SigningMethodRS256, "current", current, validClaims()), ""},
+		{"rs256 previous key", sign(t, jwt.SigningMethodRS256, "previous", previous, validClaims()), ""},
+		{"hs256 wrong secret", sign(t, jwt.SigningMethodHS256, "", []byte("other"), validClaims()), "signature is invalid"},
+		{"rs256 unknown kid", sign(t, jwt.SigningMethodRS256, "rotated-out", unknown, validClaims()), "unknown signing key"},
+		{"rs256 key swapped under a known kid", sign(t, jwt.SigningMethodRS256, "current", unknown, validClaims()), "verification error"},
+		{"hs512 not accepted", sign(t, jwt.SigningMethodHS512, "", []byte(testSecret), validClaims()), "signing method HS512 is invalid"},
+		{"expired", sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), expired), "token is expired"},
+		{"no expiry", sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), noExpiry), "exp claim is required"},
+		{"wrong issuer", sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), otherIssuer), "invalid issuer"},
+		{"wrong audience", sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), otherAudience), "invalid audience"},
+		{"garbage", "not-a-token", "token is malformed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := authenticator.ParseToken(tt.token)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ParseToken: %v", err)
+				}
+				if claims.UserID != 7 {
+					t.Fatalf("user id %d", claims.UserID)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRSAOnlyRejectsHMAC(t *testing.T) {
+	key := rsaKey(t)
+	authenticator, err := NewJWTAuthenticator(JWTConfig{RSAKeys: map[string]*rsa.PublicKey{"k": &key.PublicKey}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without a kid the only key is used.
+	if _, err := authenticator.ParseToken(sign(t, jwt.SigningMethodRS256, "", key, validClaims())); err != nil {
+		t.Fatalf("ParseToken: %v", err)
+	}
+	_, err = authenticator.ParseToken(sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims()))
+	if err == nil || !strings.Contains(err.Error(), "hmac signed tokens are not accepted") {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestNewJWTAuthenticatorNeedsKeys(t *testing.T) {
+	if _, err := NewJWTAuthenticator(JWTConfig{}); err == nil {
+		t.Fatal("expected an error without keys")
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(JWTConfig{HMACSecret: []byte(testSecret)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims())
+
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		wantClaims bool
+		wantErr    bool
+	}{
+		{"no metadata", nil, false, false},
+		{"no authorization", metadata.Pairs("x-request-id", "1"), false, false},
+		{"bearer token", metadata.Pairs("authorization", "Bearer "+token), true, false},
+		{"not bearer", metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"), false, true},
+		{"bad token", metadata.Pairs("authorization", "Bearer nope"), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			claims, err := authenticator.Authenticate(ctx)
+			if (err != nil) != tt.wantErr || (claims != nil) != tt.wantClaims {
+				t.Fatalf("got %+v, %v", claims, err)
+			}
+		})
+	}
+}
+
+func TestClaimsIdentity(t *testing.T) {
+	tests := []struct {
+		name    string
+		claims  Claims
+		want    Identity
+		wantErr bool
+	}{
+		{"user by role id", Claims{UserID: 3, RoleID: 1}, Identity{UserID: 3, Role: RoleUser}, false},
+		{"admin by role id", Claims{UserID: 3, RoleID: 2}, Identity{UserID: 3, Role: RoleAdmin}, false},
+		{"super admin by role id", Claims{UserID: 3, RoleID: 3}, Identity{UserID: 3, Role: RoleSuperAdmin}, false},
+		{"role claim wins", Claims{UserID: 3, RoleID: 1, Role: "service"}, Identity{UserID: 3, Role: RoleService}, false},
+		{"unknown role", Claims{UserID: 3, RoleID: 9}, Identity{}, true},
+		{"missing user", Claims{RoleID: 1}, Identity{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.claims.Identity()
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Fatalf("got %+v, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	key := rsaKey(t)
+	if _, err := ParseJWKS([]byte(`{"keys":[{"kty":"EC","kid":"ec"},{"kty":"RSA","kid":"enc","use":"enc","n":"AQAB","e":"AQAB"}]}`)); err == nil {
+		t.Fatal("expected an error for a set without rsa signing keys")
+	}
+	if _, err := ParseJWKS([]byte(`{`)); err == nil {
+		t.Fatal("expected an error for invalid json")
+	}
+	keys, err := ParseJWKS(jwks(t, map[string]*rsa.PrivateKey{"k1": key}))
+	if err != nil {
+		t.Fatalf("ParseJWKS: %v", err)
+	}
+	if !keys["k1"].Equal(&key.PublicKey) {
+		t.Fatal("parsed key does not match")
+	}
+}
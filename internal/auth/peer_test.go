@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test ca"},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	cert, key := issue(t, template, nil, nil)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+// leaf issues a certificate for template signed by the CA.
+func (ca *testCA) leaf(t *testing.T, template *x509.Certificate) tls.Certificate {
+	t.Helper()
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	cert, key := issue(t, template, ca.cert, ca.key)
+	return tls.Certificate{Certificate: [][]byte{cert.Raw}, PrivateKey: key, Leaf: cert}
+}
+
+// issue signs template with parentKey, or self-signs it when parent is nil.
+func issue(t *testing.T, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// handshake runs a TLS handshake in which the client presents clientCerts
+// and returns the server side connection state.
+func handshake(t *testing.T, ca *testCA, clientAuth tls.ClientAuthType, clientCerts []tls.Certificate) tls.ConnectionState {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	server := tls.Server(serverConn, &tls.Config{
+		Certificates: []tls.Certificate{ca.leaf(t, &x509.Certificate{DNSNames: []string{"movies-booking-svc"}})},
+		ClientCAs:    ca.pool,
+		ClientAuth:   clientAuth,
+	})
+	client := tls.Client(clientConn, &tls.Config{
+		RootCAs:      ca.pool,
+		ServerName:   "movies-booking-svc",
+		Certificates: clientCerts,
+	})
+	errs := make(chan error, 1)
+	go func() { errs <- client.Handshake() }()
+	if err := server.Handshake(); err != nil {
+		t.Fatalf("server handshake: %v", err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("client handshake: %v", err)
+	}
+	return server.ConnectionState()
+}
+
+func withPeer(ctx context.Context, state tls.ConnectionState) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func TestPeerName(t *testing.T) {
+	ca := newTestCA(t)
+	other := newTestCA(t)
+	spiffe, _ := url.Parse("spiffe://cluster.local/ns/default/sa/booking-worker")
+
+	tests := []struct {
+		name       string
+		clientAuth tls.ClientAuthType
+		cert       *tls.Certificate
+		want       string
+		wantOK     bool
+	}{
+		{name: "common name", clientAuth: tls.VerifyClientCertIfGiven,
+			cert: ptr(ca.leaf(t, &x509.Certificate{Subject: pkix.Name{CommonName: "booking-worker"}, DNSNames: []string{"worker.default.svc"}})),
+			want: "booking-worker", wantOK: true},
+		{name: "dns san", clientAuth: tls.VerifyClientCertIfGiven,
+			cert: ptr(ca.leaf(t, &x509.Certificate{DNSNames: []string{"worker.default.svc"}})),
+			want: "worker.default.svc", wantOK: true},
+		{name: "uri san", clientAuth: tls.VerifyClientCertIfGiven,
+			cert: ptr(ca.leaf(t, &x509.Certificate{URIs: []*url.URL{spiffe}})),
+			want: spiffe.String(), wantOK: true},
+		{name: "no client certificate", clientAuth: tls.VerifyClientCertIfGiven},
+		// Requested but not verified, so it proves nothing.
+		{name: "unverified certificate", clientAuth: tls.RequestClientCert,
+			cert: ptr(other.leaf(t, &x509.Certificate{Subject: pkix.Name{CommonName: "booking-worker"}}))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var certs []tls.Certificate
+			if tt.cert != nil {
+				certs = []tls.Certificate{*tt.cert}
+			}
+			got, ok := PeerName(withPeer(context.Background(), handshake(t, ca, tt.clientAuth, certs)))
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("PeerName = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	if _, ok := PeerName(context.Background()); ok {
+		t.Fatal("PeerName found a peer in a context without one")
+	}
+}
+
+func TestInterceptorTrustedPeer(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(JWTConfig{HMACSecret: []byte(testSecret)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unary := NewInterceptor(authenticator, testPolicies, []string{"booking-worker"}).Unary()
+	ca := newTestCA(t)
+	peerState := func(name string) tls.ConnectionState {
+		cert := ca.leaf(t, &x509.Certificate{Subject: pkix.Name{CommonName: name}})
+		return handshake(t, ca, tls.VerifyClientCertIfGiven, []tls.Certificate{cert})
+	}
+	trusted := peerState("booking-worker")
+	untrusted := peerState("reporting")
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   codes.Code
+		wantID Identity
+	}{
+		{"trusted peer", withPeer(context.Background(), trusted), defaultMethod, codes.OK,
+			Identity{Role: RoleService, Peer: "booking-worker"}},
+		{"trusted peer on owner method", withPeer(context.Background(), trusted), ownerMethod, codes.PermissionDenied, Identity{}},
+		{"trusted peer on admin method", withPeer(context.Background(), trusted), adminMethod, codes.PermissionDenied, Identity{}},
+		{"untrusted peer", withPeer(context.Background(), untrusted), defaultMethod, codes.Unauthenticated, Identity{}},
+		{"untrusted peer on public method", withPeer(context.Background(), untrusted), publicMethod, codes.OK, Identity{}},
+		// The token decides who is calling, the peer is only recorded.
+		{"token over trusted peer", withPeer(withToken(t, "user"), trusted), defaultMethod, codes.OK,
+			Identity{UserID: uint(validClaims().UserID), Role: RoleUser, Peer: "booking-worker"}},
+		{"token over untrusted peer", withPeer(withToken(t, "user"), untrusted), defaultMethod, codes.OK,
+			Identity{UserID: uint(validClaims().UserID), Role: RoleUser, Peer: "reporting"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var identity Identity
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				identity, _ = FromContext(ctx)
+				return nil, nil
+			}
+			_, err := unary(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("got %v (%v), want %v", got, err, tt.want)
+			}
+			if identity != tt.wantID {
+				t.Fatalf("identity %+v, want %+v", identity, tt.wantID)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,41 @@
+package boot
+
+import (
+	"context"
+	"time"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"google.golang.org/grpc"
+)
+
+// methodTimeouts overrides the default RPC deadline for methods that are
+// expected to run longer than a single lookup.
+var methodTimeouts = map[string]time.Duration{
+	mb.BookingService_CreateBooking_FullMethodName:                            20 * time.Second,
+	mb.TheatreService_CreateSeats_FullMethodName:                              30 * time.Second,
+	mb.TheatreService_GetTheatersAndMovieScheduleByMovieName_FullMethodName:   15 * time.Second,
+	mb.TheatreService_GetAvailableSeatsByScreenIDAndShowTimeID_FullMethodName: 15 * time.Second,
+	mb.TheatreService_GetScreensAndMovieScedulesByTheaterID_FullMethodName:    15 * time.Second,
+	mb.TheatreService_ListShowTimeByTheaterIDandMovieID_FullMethodName:        15 * time.Second,
+}
+
+// deadlineInterceptor gives every unary call a deadline so that queries stop
+// once the caller can no longer use the result. Deadlines set by clients are
+// kept when they are shorter.
+func deadlineInterceptor(defaultTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeout, ok := methodTimeouts[info.FullMethod]
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= timeout {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
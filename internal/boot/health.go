@@ -0,0 +1,120 @@
+package boot
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// LivenessService is reported SERVING for as long as the process is up and
+// not shutting down. The overall ("") status reports readiness.
+const LivenessService = "liveness"
+
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+	// Dependency checks are reported as their own service, named Name, and
+	// leave the overall readiness alone: an outage of another service must
+	// not take this one out of rotation.
+	Dependency bool
+}
+
+// HealthChecker runs the readiness checks periodically and publishes the
+// result through the grpc.health.v1 service.
+type HealthChecker struct {
+	server   *health.Server
+	checks   []HealthCheck
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu       sync.Mutex
+	failures map[string]string
+}
+
+func NewHealthChecker(interval time.Duration, logger *slog.Logger, checks ...HealthCheck) *HealthChecker {
+	server := health.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	server.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+	for _, check := range checks {
+		if check.Dependency {
+			server.SetServingStatus(check.Name, healthpb.HealthCheckResponse_NOT_SERVING)
+		}
+	}
+	return &HealthChecker{
+		server:   server,
+		checks:   checks,
+		interval: interval,
+		logger:   logger,
+		failures: map[string]string{},
+	}
+}
+
+func (h *HealthChecker) Server() *health.Server {
+	return h.server
+}
+
+// Run checks readiness immediately and then every interval until ctx is done.
+func (h *HealthChecker) Run(ctx context.Context) {
+	h.checkAll(ctx)
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.checkAll(ctx)
+		}
+	}
+}
+
+func (h *HealthChecker) checkAll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, h.interval)
+	defer cancel()
+
+	ready := true
+	for _, check := range h.checks {
+		err := check.Check(ctx)
+		h.recordResult(check.Name, err)
+		switch {
+		case check.Dependency:
+			h.server.SetServingStatus(check.Name, servingStatus(err == nil))
+		case err != nil:
+			ready = false
+		}
+	}
+	// Ignored by the health server once Shutdown was called.
+	h.server.SetServingStatus("", servingStatus(ready))
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// recordResult logs a check only when its outcome changes.
+func (h *HealthChecker) recordResult(name string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	previous, failing := h.failures[name]
+	switch {
+	case err != nil && (!failing || previous != err.Error()):
+		h.failures[name] = err.Error()
+		h.logger.Warn("readiness check failed", "check", name, "error", err)
+	case err == nil && failing:
+		delete(h.failures, name)
+		h.logger.Info("readiness check recovered", "check", name)
+	}
+}
+
+// Shutdown reports NOT_SERVING for every service, so load balancers stop
+// routing new calls while in-flight ones finish.
+func (h *HealthChecker) Shutdown() {
+	h.server.Shutdown()
+}
@@ -0,0 +1,58 @@
+package boot
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatusOf(t *testing.T, h *HealthChecker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := h.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q): %v", service, err)
+	}
+	return resp.Status
+}
+
+func TestHealthCheckerReadiness(t *testing.T) {
+	down := errors.New("down")
+	tests := []struct {
+		name        string
+		postgres    error
+		payment     error
+		wantReady   healthpb.HealthCheckResponse_ServingStatus
+		wantPayment healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{"all up", nil, nil, healthpb.HealthCheckResponse_SERVING, healthpb.HealthCheckResponse_SERVING},
+		{"dependency down", nil, down, healthpb.HealthCheckResponse_SERVING, healthpb.HealthCheckResponse_NOT_SERVING},
+		{"required check down", down, nil, healthpb.HealthCheckResponse_NOT_SERVING, healthpb.HealthCheckResponse_SERVING},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthChecker(time.Second, slog.New(slog.NewTextHandler(io.Discard, nil)),
+				HealthCheck{Name: "postgres", Check: func(context.Context) error { return tt.postgres }},
+				HealthCheck{Name: "payment", Check: func(context.Context) error { return tt.payment }, Dependency: true},
+			)
+			if got := servingStatusOf(t, h, "payment"); got != healthpb.HealthCheckResponse_NOT_SERVING {
+				t.Fatalf("payment before the first check = %s, want NOT_SERVING", got)
+			}
+
+			h.checkAll(context.Background())
+			if got := servingStatusOf(t, h, ""); got != tt.wantReady {
+				t.Errorf("readiness = %s, want %s", got, tt.wantReady)
+			}
+			if got := servingStatusOf(t, h, "payment"); got != tt.wantPayment {
+				t.Errorf("payment = %s, want %s", got, tt.wantPayment)
+			}
+			if got := servingStatusOf(t, h, LivenessService); got != healthpb.HealthCheckResponse_SERVING {
+				t.Errorf("liveness = %s, want SERVING", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package boot
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+)
+
+type MetricsServer struct {
+	server *http.Server
+	logger *slog.Logger
+}
+
+// NewMetricsServer serves /metrics on its own port so that scraping does not
+// go through the gRPC listener.
+func NewMetricsServer(config config.Config, appMetrics *metrics.Metrics, appLogger *slog.Logger) *MetricsServer {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", appMetrics.Handler())
+	return &MetricsServer{
+		server: &http.Server{
+			Addr:    "0.0.0.0:" + config.MetricsPort,
+			Handler: mux,
+		},
+		logger: appLogger,
+	}
+}
+
+func (s *MetricsServer) Serve() error {
+	s.logger.Info("metrics server started", "addr", s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *MetricsServer) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,138 @@
+package boot
+
+import (
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/checkout"
+	rbacpb "github.com/aparnasukesh/movies-booking-svc/pkg/pb/rbac"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/scheduling"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/ticketing"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// methodPolicies lists the auth policy of every RPC. Methods missing here
+// require an authenticated caller.
+var methodPolicies = map[string]auth.Policy{
+	// Movies
+	mb.MovieService_ListMovies_FullMethodName:                auth.PolicyPublic,
+	mb.MovieService_GetMovieDetailsByID_FullMethodName:       auth.PolicyPublic,
+	mb.MovieService_GetMovieByName_FullMethodName:            auth.PolicyPublic,
+	mb.MovieService_GetMoviesByGenre_FullMethodName:          auth.PolicyPublic,
+	mb.MovieService_GetMoviesByLanguage_FullMethodName:       auth.PolicyPublic,
+	mb.MovieService_GetMovieByNameAndLanguage_FullMethodName: auth.PolicyPublic,
+	mb.MovieService_RegisterMovie_FullMethodName:             auth.PolicyAdmin,
+	mb.MovieService_UpdateMovie_FullMethodName:               auth.PolicyAdmin,
+	mb.MovieService_DeleteMovie_FullMethodName:               auth.PolicyAdmin,
+	// Catalog
+	catalog.CatalogService_SearchMovies_FullMethodName:       auth.PolicyPublic,
+	catalog.CatalogService_GetMovieDetails_FullMethodName:    auth.PolicyPublic,
+	catalog.CatalogService_ListMoviesByPerson_FullMethodName: auth.PolicyPublic,
+	catalog.CatalogService_ListGenres_FullMethodName:         auth.PolicyPublic,
+	catalog.CatalogService_GetPerson_FullMethodName:          auth.PolicyPublic,
+	catalog.CatalogService_ListPeople_FullMethodName:         auth.PolicyPublic,
+	catalog.CatalogService_SetMovieMetadata_FullMethodName:   auth.PolicyAdmin,
+	catalog.CatalogService_CreateGenre_FullMethodName:        auth.PolicyAdmin,
+	catalog.CatalogService_DeleteGenre_FullMethodName:        auth.PolicyAdmin,
+	catalog.CatalogService_SetMovieGenres_FullMethodName:     auth.PolicyAdmin,
+	catalog.CatalogService_CreatePerson_FullMethodName:       auth.PolicyAdmin,
+	catalog.CatalogService_UpdatePerson_FullMethodName:       auth.PolicyAdmin,
+	catalog.CatalogService_DeletePerson_FullMethodName:       auth.PolicyAdmin,
+	catalog.CatalogService_SetMovieCredits_FullMethodName:    auth.PolicyAdmin,
+	catalog.CatalogService_ListMovieVersions_FullMethodName:  auth.PolicyPublic,
+	catalog.CatalogService_CreateMovieVersion_FullMethodName: auth.PolicyAdmin,
+	catalog.CatalogService_DeleteMovieVersion_FullMethodName: auth.PolicyAdmin,
+	// Theater types
+	mb.TheatreService_GetTheaterTypeByID_FullMethodName:      auth.PolicyPublic,
+	mb.TheatreService_GetTheaterTypeByName_FullMethodName:    auth.PolicyPublic,
+	mb.TheatreService_ListTheaterTypes_FullMethodName:        auth.PolicyPublic,
+	mb.TheatreService_AddTheaterType_FullMethodName:          auth.PolicyAdmin,
+	mb.TheatreService_UpdateTheaterType_FullMethodName:       auth.PolicyAdmin,
+	mb.TheatreService_DeleteTheaterTypeByID_FullMethodName:   auth.PolicyAdmin,
+	mb.TheatreService_DeleteTheaterTypeByName_FullMethodName: auth.PolicyAdmin,
+	// Screen types
+	mb.TheatreService_GetScreenTypeByID_FullMethodName:      auth.PolicyPublic,
+	mb.TheatreService_GetScreenTypeByName_FullMethodName:    auth.PolicyPublic,
+	mb.TheatreService_ListScreenTypes_FullMethodName:        auth.PolicyPublic,
+	mb.TheatreService_AddScreenType_FullMethodName:          auth.PolicyAdmin,
+	mb.TheatreService_UpdateScreenType_FullMethodName:       auth.PolicyAdmin,
+	mb.TheatreService_DeleteScreenTypeByID_FullMethodName:   auth.PolicyAdmin,
+	mb.TheatreService_DeleteScreenTypeByName_FullMethodName: auth.PolicyAdmin,
+	// Seat categories
+	mb.TheatreService_GetSeatCategoryByID_FullMethodName:      auth.PolicyPublic,
+	mb.TheatreService_GetSeatCategoryByName_FullMethodName:    auth.PolicyPublic,
+	mb.TheatreService_ListSeatCategories_FullMethodName:       auth.PolicyPublic,
+	mb.TheatreService_AddSeatCategory_FullMethodName:          auth.PolicyAdmin,
+	mb.TheatreService_UpdateSeatCategory_FullMethodName:       auth.PolicyAdmin,
+	mb.TheatreService_DeleteSeatCategoryByID_FullMethodName:   auth.PolicyAdmin,
+	mb.TheatreService_DeleteSeatCategoryByName_FullMethodName: auth.PolicyAdmin,
+	// Theaters
+	mb.TheatreService_GetTheaterByID_FullMethodName:                         auth.PolicyPublic,
+	mb.TheatreService_GetTheaterByName_FullMethodName:                       auth.PolicyPublic,
+	mb.TheatreService_ListTheaters_FullMethodName:                           auth.PolicyPublic,
+	mb.TheatreService_GetTheatersByCity_FullMethodName:                      auth.PolicyPublic,
+	mb.TheatreService_GetTheatersAndMovieScheduleByMovieName_FullMethodName: auth.PolicyPublic,
+	mb.TheatreService_GetScreensAndMovieScedulesByTheaterID_FullMethodName:  auth.PolicyPublic,
+	mb.TheatreService_AddTheater_FullMethodName:                             auth.PolicyTheaterOwner,
+	mb.TheatreService_UpdateTheater_FullMethodName:                          auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteTheaterByID_FullMethodName:                      auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteTheaterByName_FullMethodName:                    auth.PolicyTheaterOwner,
+	// Theater screens
+	mb.TheatreService_GetTheaterScreenByID_FullMethodName:        auth.PolicyPublic,
+	mb.TheatreService_GetTheaterScreenByNumber_FullMethodName:    auth.PolicyPublic,
+	mb.TheatreService_ListTheaterScreens_FullMethodName:          auth.PolicyPublic,
+	mb.TheatreService_AddTheaterScreen_FullMethodName:            auth.PolicyTheaterOwner,
+	mb.TheatreService_UpdateTheaterScreen_FullMethodName:         auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteTheaterScreenByID_FullMethodName:     auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteTheaterScreenByNumber_FullMethodName: auth.PolicyTheaterOwner,
+	// Showtimes
+	mb.TheatreService_GetShowtimeByID_FullMethodName:                   auth.PolicyPublic,
+	mb.TheatreService_GetShowtimeByDetails_FullMethodName:              auth.PolicyPublic,
+	mb.TheatreService_ListShowtimes_FullMethodName:                     auth.PolicyPublic,
+	mb.TheatreService_ListShowtimesByShowDateAndMovieID_FullMethodName: auth.PolicyPublic,
+	mb.TheatreService_ListShowTimeByTheaterID_FullMethodName:           auth.PolicyPublic,
+	mb.TheatreService_ListShowTimeByTheaterIDandMovieID_FullMethodName: auth.PolicyPublic,
+	mb.TheatreService_AddShowtime_FullMethodName:                       auth.PolicyTheaterOwner,
+	mb.TheatreService_UpdateShowtime_FullMethodName:                    auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteShowtimeByID_FullMethodName:                auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteShowtimeByDetails_FullMethodName:           auth.PolicyTheaterOwner,
+	// Movie schedules
+	mb.TheatreService_GetAllMovieSchedules_FullMethodName:                                  auth.PolicyPublic,
+	mb.TheatreService_GetMovieScheduleByID_FullMethodName:                                  auth.PolicyPublic,
+	mb.TheatreService_GetMovieScheduleByMovieID_FullMethodName:                             auth.PolicyPublic,
+	mb.TheatreService_GetMovieScheduleByTheaterID_FullMethodName:                           auth.PolicyPublic,
+	mb.TheatreService_GetMovieScheduleByMovieIdAndTheaterId_FullMethodName:                 auth.PolicyPublic,
+	mb.TheatreService_GetMovieScheduleByMovieIdAndShowTimeId_FullMethodName:                auth.PolicyPublic,
+	mb.TheatreService_GetMovieScheduleByTheaterIdAndShowTimeId_FullMethodName:              auth.PolicyPublic,
+	mb.TheatreService_AddMovieSchedule_FullMethodName:                                      auth.PolicyTheaterOwner,
+	mb.TheatreService_UpdateMovieSchedule_FullMethodName:                                   auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteMovieScheduleById_FullMethodName:                               auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteMovieScheduleByMovieIdAndTheaterId_FullMethodName:              auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteMovieScheduleByMovieIdAndTheaterIdAndShowTimeId_FullMethodName: auth.PolicyTheaterOwner,
+	// Seats
+	mb.TheatreService_GetSeatsByScreenID_FullMethodName:                       auth.PolicyPublic,
+	mb.TheatreService_GetAvailableSeatsByScreenIDAndShowTimeID_FullMethodName: auth.PolicyPublic,
+	mb.TheatreService_GetSeatByID_FullMethodName:                              auth.PolicyPublic,
+	mb.TheatreService_GetSeatBySeatNumberAndScreenID_FullMethodName:           auth.PolicyPublic,
+	mb.TheatreService_CreateSeats_FullMethodName:                              auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteSeatByID_FullMethodName:                           auth.PolicyTheaterOwner,
+	mb.TheatreService_DeleteSeatBySeatNumberAndScreenID_FullMethodName:        auth.PolicyTheaterOwner,
+	// Scheduling
+	scheduling.SchedulingService_GetScreenTypeFormats_FullMethodName: auth.PolicyPublic,
+	scheduling.SchedulingService_GetShowtime_FullMethodName:          auth.PolicyPublic,
+	scheduling.SchedulingService_SetScreenTypeFormats_FullMethodName: auth.PolicyAdmin,
+	scheduling.SchedulingService_AddShowtime_FullMethodName:          auth.PolicyTheaterOwner,
+	scheduling.SchedulingService_SetShowtimeVersion_FullMethodName:   auth.PolicyTheaterOwner,
+	// Tickets
+	ticketing.TicketService_GetTicketPublicKey_FullMethodName: auth.PolicyPublic,
+	ticketing.TicketService_CheckIn_FullMethodName:            auth.PolicyTheaterOwner,
+	// Checkout; payment results are authenticated by their signature.
+	checkout.CheckoutService_PaymentResult_FullMethodName: auth.PolicyPublic,
+	// Health
+	healthpb.Health_Check_FullMethodName: auth.PolicyPublic,
+	healthpb.Health_Watch_FullMethodName: auth.PolicyPublic,
+	// Theater staff
+	rbacpb.StaffService_AssignTheaterStaff_FullMethodName: auth.PolicyTheaterOwner,
+	rbacpb.StaffService_RemoveTheaterStaff_FullMethodName: auth.PolicyTheaterOwner,
+	rbacpb.StaffService_ListTheaterStaff_FullMethodName:   auth.PolicyTheaterOwner,
+}
@@ -1,35 +1,88 @@
 package boot
 
 import (
-	"log"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
 	"net"
 
 	"github.com/aparnasukesh/inter-communication/movie_booking"
 	"github.com/aparnasukesh/movies-booking-svc/config"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/booking"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/rbac"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/checkout"
+	rbacpb "github.com/aparnasukesh/movies-booking-svc/pkg/pb/rbac"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/scheduling"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/ticketing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-func NewGrpcServer(config config.Config, movieGrpcHandler movies.GrpcHandler, theatresGrpcHandler theatres.GrpcHandler, bookingGrpcHandler booking.GrpcHandler) (func() error, error) {
-	//lis, err := net.Listen("tcp", ":"+config.GrpcPort)
-	lis, err := net.Listen("tcp", "0.0.0.0:"+config.GrpcPort)
+type GrpcServer struct {
+	server *grpc.Server
+	lis    net.Listener
+	tls    bool
+	logger *slog.Logger
+}
 
-	if err != nil {
-		return nil, err
+// NewGrpcServer registers every service on a server that will accept
+// connections from lis, over TLS when tlsConfig is not nil.
+func NewGrpcServer(config config.Config, lis net.Listener, tlsConfig *tls.Config, movieGrpcHandler movies.GrpcHandler, catalogGrpcHandler movies.CatalogGrpcHandler, theatresGrpcHandler theatres.GrpcHandler, schedulingGrpcHandler theatres.SchedulingGrpcHandler, bookingGrpcHandler booking.GrpcHandler, ticketGrpcHandler booking.TicketGrpcHandler, checkoutGrpcHandler booking.CheckoutGrpcHandler, rbacGrpcHandler rbac.GrpcHandler, authenticator auth.Authenticator, appLogger *slog.Logger, appMetrics *metrics.Metrics, healthChecker *HealthChecker) *GrpcServer {
+	authInterceptor := auth.NewInterceptor(authenticator, methodPolicies, trustedClients(config))
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(appLogger), appMetrics.UnaryServerInterceptor(), apperrors.UnaryServerInterceptor(), deadlineInterceptor(config.RPCTimeout), authInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(appLogger), appMetrics.StreamServerInterceptor(), apperrors.StreamServerInterceptor(), authInterceptor.Stream()),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
-	s := grpc.NewServer()
+	s := grpc.NewServer(opts...)
 	movie_booking.RegisterMovieServiceServer(s, &movieGrpcHandler)
+	catalog.RegisterCatalogServiceServer(s, &catalogGrpcHandler)
 	movie_booking.RegisterTheatreServiceServer(s, &theatresGrpcHandler)
+	scheduling.RegisterSchedulingServiceServer(s, &schedulingGrpcHandler)
 	movie_booking.RegisterBookingServiceServer(s, &bookingGrpcHandler)
-	srv := func() error {
-		log.Printf("gRPC server started on port %s", config.GrpcPort)
-		if err := s.Serve(lis); err != nil {
-			log.Fatalf("failed to serve: %v", err)
-			return err
-		}
+	ticketing.RegisterTicketServiceServer(s, &ticketGrpcHandler)
+	checkout.RegisterCheckoutServiceServer(s, &checkoutGrpcHandler)
+	rbacpb.RegisterStaffServiceServer(s, &rbacGrpcHandler)
+	healthpb.RegisterHealthServer(s, healthChecker.Server())
+	return &GrpcServer{
+		server: s,
+		lis:    lis,
+		tls:    tlsConfig != nil,
+		logger: appLogger,
+	}
+}
+
+func (s *GrpcServer) Serve() error {
+	s.logger.Info("gRPC server started", "addr", s.lis.Addr().String(), "tls", s.tls)
+	return s.server.Serve(s.lis)
+}
+
+// Stop waits for in-flight calls to finish and cancels them once ctx expires.
+func (s *GrpcServer) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
 		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		<-done
+		return fmt.Errorf("graceful stop timed out, in-flight calls were cancelled: %w", ctx.Err())
 	}
-	return srv, nil
 }
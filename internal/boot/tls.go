@@ -0,0 +1,52 @@
+package boot
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/tlsreload"
+)
+
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"":         tls.NoClientCert,
+	"none":     tls.NoClientCert,
+	"optional": tls.VerifyClientCertIfGiven,
+	"require":  tls.RequireAndVerifyClientCert,
+}
+
+// NewServerTLS returns the reloader for the gRPC server certificate, or nil
+// when no certificate is configured and the server runs in plaintext.
+// Without a client CA the default "optional" client auth asks for no client
+// certificates, and "require" is an error.
+func NewServerTLS(config config.Config, appLogger *slog.Logger) (*tlsreload.Reloader, error) {
+	if config.GrpcServerCertFile == "" && config.GrpcServerKeyFile == "" {
+		return nil, nil
+	}
+	clientAuth, ok := clientAuthTypes[config.GrpcServerClientAuth]
+	if !ok {
+		return nil, fmt.Errorf("unknown client auth %q", config.GrpcServerClientAuth)
+	}
+	if config.GrpcServerClientCAFile == "" {
+		switch clientAuth {
+		case tls.RequireAndVerifyClientCert:
+			return nil, errors.New("GrpcServerClientAuth is require but GrpcServerClientCAFile is empty")
+		case tls.VerifyClientCertIfGiven:
+			clientAuth = tls.NoClientCert
+		}
+	}
+	return tlsreload.New(config.GrpcServerCertFile, config.GrpcServerKeyFile, config.GrpcServerClientCAFile, clientAuth, config.GrpcServerTLSReload, appLogger)
+}
+
+func trustedClients(config config.Config) []string {
+	var names []string
+	for _, name := range strings.Split(config.GrpcServerTrustedClients, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,110 @@
+package boot
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/config"
+)
+
+// writeCert writes a self-signed certificate and its key to dir. The
+// certificate doubles as the client CA.
+func writeCert(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "movies-booking-svc"},
+		DNSNames:              []string{"movies-booking-svc"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestNewServerTLS(t *testing.T) {
+	certFile, keyFile := writeCert(t, t.TempDir())
+
+	tests := []struct {
+		name       string
+		clientAuth string
+		clientCA   string
+		want       tls.ClientAuthType
+		wantErr    bool
+	}{
+		{name: "default without ca", clientAuth: "", want: tls.NoClientCert},
+		{name: "optional without ca", clientAuth: "optional", want: tls.NoClientCert},
+		{name: "require without ca", clientAuth: "require", wantErr: true},
+		{name: "none with ca", clientAuth: "none", clientCA: certFile, want: tls.NoClientCert},
+		{name: "optional with ca", clientAuth: "optional", clientCA: certFile, want: tls.VerifyClientCertIfGiven},
+		{name: "require with ca", clientAuth: "require", clientCA: certFile, want: tls.RequireAndVerifyClientCert},
+		{name: "unknown", clientAuth: "always", clientCA: certFile, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reloader, err := NewServerTLS(config.Config{
+				GrpcServerCertFile:     certFile,
+				GrpcServerKeyFile:      keyFile,
+				GrpcServerClientCAFile: tt.clientCA,
+				GrpcServerClientAuth:   tt.clientAuth,
+				GrpcServerTLSReload:    time.Minute,
+			}, slog.Default())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewServerTLS: %v", err)
+			}
+			serverConfig, err := reloader.Config().GetConfigForClient(&tls.ClientHelloInfo{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if serverConfig.ClientAuth != tt.want {
+				t.Fatalf("client auth %v, want %v", serverConfig.ClientAuth, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewServerTLSPlaintext(t *testing.T) {
+	reloader, err := NewServerTLS(config.Config{GrpcServerClientAuth: "require"}, slog.Default())
+	if reloader != nil || err != nil {
+		t.Fatalf("NewServerTLS without a certificate = %v, %v, want plaintext", reloader, err)
+	}
+}
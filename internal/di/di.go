@@ -1,55 +1,307 @@
 package di
 
 import (
-	"log"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"time"
 
+	"github.com/aparnasukesh/inter-communication/notification"
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/inter-communication/user_admin"
 	"github.com/aparnasukesh/movies-booking-svc/config"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/booking"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/rbac"
 	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
 	"github.com/aparnasukesh/movies-booking-svc/internal/boot"
+	"github.com/aparnasukesh/movies-booking-svc/internal/lifecycle"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/eticket"
 	grpclient "github.com/aparnasukesh/movies-booking-svc/pkg/grpClient"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/metrics"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/paycallback"
 	redis "github.com/aparnasukesh/movies-booking-svc/pkg/redis"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/resilience"
 	sql "github.com/aparnasukesh/movies-booking-svc/pkg/sql"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/tlsreload"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/tracing"
+	"github.com/go-redis/redis/extra/redisotel/v8"
+	goredis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+	otelgorm "gorm.io/plugin/opentelemetry/tracing"
 )
 
-func InitResources(cfg config.Config) (func() error, error) {
+// InitResources builds the dependency graph. Resources are registered on the
+// returned manager as they are opened, so a failure part way through closes
+// whatever was already opened.
+func InitResources(cfg config.Config, logger *slog.Logger) (app *lifecycle.Manager, err error) {
+	slog.SetDefault(logger)
+	app = lifecycle.New(logger, cfg.ShutdownTimeout)
+	defer func() {
+		if err != nil {
+			app.Close()
+			app = nil
+		}
+	}()
+
+	// Tracing initialization
+	tracerProvider, err := tracing.NewProvider(context.Background(), tracing.Config{
+		Exporter:     cfg.TraceExporter,
+		OTLPEndpoint: cfg.OTLPEndpoint,
+		OTLPInsecure: cfg.OTLPInsecure,
+		SampleRatio:  cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		return nil, err
+	}
+	app.AddCloser("tracer provider", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return tracerProvider.Shutdown(ctx)
+	})
 
 	// Db initialization
 	db, err := sql.NewSql(cfg)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	app.AddCloser("postgres", sqlDB.Close)
+	if cfg.MigrateOnStartup {
+		migrator, err := sql.NewMigrator(sqlDB, logger)
+		if err != nil {
+			return nil, err
+		}
+		if err := migrator.Up(context.Background(), 0); err != nil {
+			return nil, err
+		}
 	}
 
 	// Redis initialization
 	redisClient, err := redis.NewRedis(cfg)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+	app.AddCloser("redis", redisClient.Close)
+
+	// Outbound clients initialization
+	clients, err := grpclient.NewFactory(cfg)
+	if err != nil {
+		return nil, err
+	}
+	paymentSvcClient, paymentConn, err := clients.NewBookingPaymentServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	app.AddCloser("payment connection", paymentConn.Close)
+	userAdminClient, userAdminConn, err := clients.NewUserAdminServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	app.AddCloser("user admin connection", userAdminConn.Close)
+	notificationClient, notificationConn, err := clients.NewNotificationServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	app.AddCloser("notification connection", notificationConn.Close)
+
+	if err := db.Use(otelgorm.NewPlugin(otelgorm.WithDBName(cfg.DBName), otelgorm.WithoutMetrics())); err != nil {
+		return nil, err
+	}
+	redisClient.AddHook(redisotel.NewTracingHook())
+
+	lis, err := net.Listen("tcp", "0.0.0.0:"+cfg.GrpcPort)
+	if err != nil {
+		return nil, err
+	}
+	application, err := NewApplication(cfg, logger, Infrastructure{
+		DB:                 db,
+		Redis:              redisClient,
+		PaymentClient:      paymentSvcClient,
+		PaymentConn:        paymentConn,
+		UserAdminClient:    userAdminClient,
+		UserAdminConn:      userAdminConn,
+		NotificationClient: notificationClient,
+		NotificationConn:   notificationConn,
+	}, lis)
+	if err != nil {
+		lis.Close()
+		return nil, err
+	}
+	metricsServer := boot.NewMetricsServer(cfg, application.Metrics, logger)
+
+	app.OnShutdown(application.HealthChecker.Shutdown)
+	app.AddServer("grpc", application.GrpcServer.Serve, application.GrpcServer.Stop)
+	app.AddServer("metrics", metricsServer.Serve, metricsServer.Stop)
+	app.AddWorker("health checker", application.HealthChecker.Run)
+	app.AddWorker("payment reconciler", application.PaymentReconciler.Run)
+	if application.ServerTLS != nil {
+		app.AddWorker("tls reloader", application.ServerTLS.Run)
+	}
+	return app, nil
+}
+
+// Infrastructure holds the external connections the application is built
+// on. InitResources opens them from config; the integration harness passes
+// in its own.
+type Infrastructure struct {
+	DB                 *gorm.DB
+	Redis              *goredis.Client
+	PaymentClient      payment.PaymentServiceClient
+	PaymentConn        *grpc.ClientConn
+	UserAdminClient    user_admin.SuperAdminServiceClient
+	UserAdminConn      *grpc.ClientConn
+	NotificationClient notification.EmailServiceClient
+	NotificationConn   *grpc.ClientConn
+}
+
+type Application struct {
+	Services          *Services
+	Metrics           *metrics.Metrics
+	HealthChecker     *boot.HealthChecker
+	GrpcServer        *boot.GrpcServer
+	PaymentReconciler *booking.PaymentReconciler
+	// ServerTLS is nil when the server runs in plaintext.
+	ServerTLS *tlsreload.Reloader
+}
+
+// NewApplication wires services, handlers and the gRPC server on top of
+// infra. The server is not started.
+func NewApplication(cfg config.Config, logger *slog.Logger, infra Infrastructure, lis net.Listener) (*Application, error) {
+	sqlDB, err := infra.DB.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Metrics initialization
+	appMetrics := metrics.New()
+	if err := appMetrics.RegisterDB(sqlDB, cfg.DBName); err != nil {
+		return nil, err
+	}
+
+	users := grpclient.NewUserDirectory(infra.UserAdminClient, cfg.UserAdminCacheTTL)
+	services, err := NewServices(cfg, infra.DB, infra.Redis, infra.PaymentClient, users, appMetrics)
+	if err != nil {
+		return nil, err
+	}
+	rbacGrpcHandler := rbac.NewGrpcHandler(services.RBAC)
+	movieGrpcHandler := movies.NewGrpcHandler(services.Movies)
+	catalogGrpcHandler := movies.NewCatalogGrpcHandler(services.Movies)
+	theatresGrpcHandler := theatres.NewGrpcHandler(services.Theatres)
+	schedulingGrpcHandler := theatres.NewSchedulingGrpcHandler(services.Theatres)
+	bookingGrpcHandler := booking.NewGrpcHandler(services.Booking)
+	ticketGrpcHandler := booking.NewTicketGrpcHandler(services.Booking)
+	checkoutGrpcHandler := booking.NewCheckoutGrpcHandler(services.Booking)
+
+	// Auth initialization
+	jwtConfig := auth.JWTConfig{
+		HMACSecret: []byte(cfg.JWTSecret),
+		Issuer:     cfg.JWTIssuer,
+		Audience:   cfg.JWTAudience,
+	}
+	if cfg.JWKSFile != "" {
+		jwtConfig.RSAKeys, err = auth.LoadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	authenticator, err := auth.NewJWTAuthenticator(jwtConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Health initialization
+	healthChecker := boot.NewHealthChecker(cfg.HealthCheckInterval, logger,
+		boot.HealthCheck{Name: "postgres", Check: sqlDB.PingContext},
+		boot.HealthCheck{Name: "redis", Check: func(ctx context.Context) error {
+			return infra.Redis.Ping(ctx).Err()
+		}},
+		// Reported as its own service; a payment outage only affects checkout.
+		boot.HealthCheck{Name: "payment", Check: grpclient.ConnReady(infra.PaymentConn), Dependency: true},
+		boot.HealthCheck{Name: "notification", Check: grpclient.ConnReady(infra.NotificationConn), Dependency: true},
+	)
+
+	// Server initialization
+	serverTLS, err := boot.NewServerTLS(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	var tlsConfig *tls.Config
+	if serverTLS != nil {
+		tlsConfig = serverTLS.Config()
+	}
+	server := boot.NewGrpcServer(cfg, lis, tlsConfig, movieGrpcHandler, catalogGrpcHandler, theatresGrpcHandler, schedulingGrpcHandler, bookingGrpcHandler, ticketGrpcHandler, checkoutGrpcHandler, rbacGrpcHandler, authenticator, logger, appMetrics, healthChecker)
+
+	return &Application{
+		Services:          services,
+		Metrics:           appMetrics,
+		HealthChecker:     healthChecker,
+		GrpcServer:        server,
+		PaymentReconciler: booking.NewPaymentReconciler(services.Booking, cfg.PaymentReconcileInterval, cfg.PaymentStaleAfter, logger),
+		ServerTLS:         serverTLS,
+	}, nil
+}
+
+// Services is the application layer shared by the gRPC server and the
+// command line tools.
+type Services struct {
+	RBAC     rbac.Service
+	Movies   movies.Service
+	Theatres theatres.Service
+	Booking  booking.Service
+}
+
+func NewServices(cfg config.Config, db *gorm.DB, redisClient *goredis.Client, paymentClient payment.PaymentServiceClient, users grpclient.UserDirectory, appMetrics *metrics.Metrics) (*Services, error) {
+	// RBAC Module Initialization
+	rbacRepo := rbac.NewRepository(db)
+	rbacService := rbac.NewService(rbacRepo)
 
 	// // Movie Module Initialization
 	movieRepo := movies.NewRepository(db)
-	movieService := movies.NewService(movieRepo, redisClient)
-	movieGrpcHandler := movies.NewGrpcHandler(movieService)
+	movieService := movies.NewService(movieRepo, redisClient, rbacService, appMetrics)
 
 	// Theatres Module initialization
 	theaterRepo := theatres.NewRepository(db)
-	service := theatres.NewService(theaterRepo, movieRepo)
-	theatresGrpcHandler := theatres.NewGrpcHandler(service)
+	theaterService := theatres.NewService(theaterRepo, movieRepo, rbacService, users)
 
 	// Booking Module Initialization
-	paymentSvcClient, err := grpclient.NewBookingPaymentServiceClient(cfg.GrpcPaymentPort)
+	ticketSigner, err := eticket.NewSigner(cfg.TicketSigningKey)
 	if err != nil {
 		return nil, err
 	}
+	paymentBreaker := resilience.NewBreaker(cfg.PaymentBreakerThreshold, cfg.PaymentBreakerCooldown, func(state resilience.State) {
+		slog.Warn("payment circuit breaker changed state", "state", state.String())
+		appMetrics.CircuitBreakerState("payment", int(state))
+	})
+	paymentClient = grpclient.NewResilientPaymentClient(paymentClient, paymentBreaker, resilience.Backoff{
+		Attempts: cfg.PaymentRetryAttempts,
+		Initial:  cfg.PaymentRetryBackoff,
+		Max:      cfg.PaymentRetryMaxBackoff,
+	})
+	var callbacks *paycallback.Verifier
+	if cfg.PaymentCallbacks {
+		callbacks, err = paycallback.NewVerifier(cfg.PaymentCallbackSecret, cfg.PaymentCallbackMaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("PaymentCallbacks is on: %w", err)
+		}
+	}
 	bookingRepo := booking.NewRepository(db)
-	bookingService := booking.NewService(db, bookingRepo, movieRepo, theaterRepo, paymentSvcClient)
-	bookingGrpcHandler := booking.NewGrpcHandler(bookingService)
+	bookingService := booking.NewService(db, bookingRepo, movieRepo, theaterRepo, rbacService, paymentClient, callbacks, users, ticketSigner, booking.CheckInWindow{
+		OpensBefore: cfg.CheckInOpensBefore,
+		ClosesAfter: cfg.CheckInClosesAfter,
+	}, booking.AgeRatingPolicy(cfg.AgeRatingPolicy), appMetrics)
 
-	// Server initialization
-	server, err := boot.NewGrpcServer(cfg, movieGrpcHandler, theatresGrpcHandler, bookingGrpcHandler)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return server, nil
+	return &Services{
+		RBAC:     rbacService,
+		Movies:   movieService,
+		Theatres: theaterService,
+		Booking:  bookingService,
+	}, nil
 }
@@ -0,0 +1,202 @@
+package e2e
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/inter-communication/user_admin"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/paycallback"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/checkout"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/ticketing"
+	"google.golang.org/grpc/codes"
+)
+
+const userID = 42
+
+// bookSeats schedules a show in cinema and books A1 and A2 on it as userID.
+func bookSeats(t *testing.T, h *harness.Harness) *mb.Booking {
+	t.Helper()
+	h.Load(cinema)
+	h.UserAdmin.AddUser(&user_admin.User{Id: userID, IsVerified: true})
+	screen := screenID(t, h)
+	show := addShowtime(t, h, registerMovie(t, h, "Interstellar"), screen)
+
+	resp, err := h.Bookings.CreateBooking(h.AsUser(userID), &mb.CreateBookingRequest{
+		UserId:     userID,
+		ShowtimeId: show.Id,
+		SeatIds:    seatIDs(t, h, screen, "A1", "A2"),
+	})
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+	return resp.Booking
+}
+
+func paymentResult(h *harness.Harness, b *mb.Booking, transactionID int32, outcome checkout.PaymentOutcome, amount float64) *checkout.PaymentResultRequest {
+	now := time.Unix(time.Now().Unix(), 0)
+	req := &checkout.PaymentResultRequest{
+		BookingId:     b.BookingId,
+		TransactionId: transactionID,
+		OrderId:       "order-1",
+		Outcome:       outcome,
+		Amount:        amount,
+		Timestamp:     now.Unix(),
+	}
+	req.Signature = paycallback.Sign([]byte(h.Config.PaymentCallbackSecret), paycallback.Result{
+		BookingID:     req.BookingId,
+		TransactionID: req.TransactionId,
+		OrderID:       req.OrderId,
+		Outcome:       outcome.String(),
+		Amount:        req.Amount,
+		Timestamp:     now,
+	})
+	return req
+}
+
+func TestBookingFlow(t *testing.T) {
+	h := harness.New(t)
+	h.Payment.ProcessPaymentFunc = func(ctx context.Context, req *payment.ProcessPaymentRequest) (*payment.ProcessPaymentResponse, error) {
+		return &payment.ProcessPaymentResponse{Transaction: &payment.Transaction{
+			TransactionId: 900,
+			BookingId:     req.BookingId,
+			Amount:        req.Amount,
+			OrderId:       "order-1",
+			Status:        "Pending",
+		}}, nil
+	}
+	created := bookSeats(t, h)
+	if created.TotalAmount != 300 || created.PaymentStatus != booking.PaymentStatusPending {
+		t.Fatalf("unexpected booking %+v", created)
+	}
+
+	// The seats are taken now.
+	h.UserAdmin.AddUser(&user_admin.User{Id: userID + 1, IsVerified: true})
+	_, err := h.Bookings.CreateBooking(h.AsUser(userID+1), &mb.CreateBookingRequest{
+		UserId:     userID + 1,
+		ShowtimeId: created.ShowtimeId,
+		SeatIds:    []uint32{created.BookingSeats[0].SeatId},
+	})
+	wantCode(t, err, codes.Aborted)
+
+	started, err := h.Checkout.StartPayment(h.AsUser(userID), &checkout.StartPaymentRequest{BookingId: created.BookingId, PaymentMethodId: 1})
+	if err != nil {
+		t.Fatalf("StartPayment: %v", err)
+	}
+	if started.PaymentStatus != booking.PaymentStatusProcessing || started.TransactionId != 900 || started.OrderId != "order-1" {
+		t.Fatalf("unexpected payment %+v", started)
+	}
+	_, err = h.Tickets.GetTicket(h.AsUser(userID), &ticketing.GetTicketRequest{BookingId: created.BookingId})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	result := paymentResult(h, created, 900, checkout.PaymentOutcome_SUCCEEDED, 300)
+	paid, err := h.Checkout.PaymentResult(context.Background(), result)
+	if err != nil {
+		t.Fatalf("PaymentResult: %v", err)
+	}
+	if !paid.Applied || paid.PaymentStatus != booking.PaymentStatusSuccess {
+		t.Fatalf("unexpected result %+v", paid)
+	}
+	// A redelivered callback is accepted without changing anything.
+	again, err := h.Checkout.PaymentResult(context.Background(), result)
+	if err != nil || again.Applied {
+		t.Fatalf("redelivery: %+v, %v", again, err)
+	}
+
+	ticket, err := h.Tickets.GetTicket(h.AsUser(userID), &ticketing.GetTicketRequest{BookingId: created.BookingId})
+	if err != nil {
+		t.Fatalf("GetTicket: %v", err)
+	}
+	seats := slices.Clone(ticket.Ticket.SeatNumbers)
+	slices.Sort(seats)
+	if !slices.Equal(seats, []string{"A1", "A2"}) || ticket.Ticket.Payload == "" || len(ticket.Ticket.QrCodePng) == 0 {
+		t.Fatalf("unexpected ticket %+v", ticket.Ticket)
+	}
+	// The ticket is valid exactly while check-in is open.
+	validFrom, validUntil := ticket.Ticket.ValidFrom.AsTime(), ticket.Ticket.ValidUntil.AsTime()
+	if got, want := validUntil.Sub(validFrom), h.Config.CheckInOpensBefore+h.Config.CheckInClosesAfter; got != want {
+		t.Fatalf("ticket valid for %s, want the %s check-in window", got, want)
+	}
+	// Only the booking's user gets the ticket.
+	_, err = h.Tickets.GetTicket(h.AsUser(userID+1), &ticketing.GetTicketRequest{BookingId: created.BookingId})
+	wantCode(t, err, codes.PermissionDenied)
+}
+
+func TestPaymentResultRejected(t *testing.T) {
+	h := harness.New(t)
+	created := bookSeats(t, h)
+
+	tests := []struct {
+		name string
+		req  func() *checkout.PaymentResultRequest
+		want codes.Code
+	}{
+		{"bad signature", func() *checkout.PaymentResultRequest {
+			req := paymentResult(h, created, 900, checkout.PaymentOutcome_SUCCEEDED, 300)
+			req.Amount = 1
+			return req
+		}, codes.Unauthenticated},
+		{"short payment", func() *checkout.PaymentResultRequest {
+			return paymentResult(h, created, 900, checkout.PaymentOutcome_SUCCEEDED, 299)
+		}, codes.FailedPrecondition},
+		{"no outcome", func() *checkout.PaymentResultRequest {
+			return paymentResult(h, created, 900, checkout.PaymentOutcome_PAYMENT_OUTCOME_UNSPECIFIED, 300)
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.Checkout.PaymentResult(context.Background(), tt.req())
+			wantCode(t, err, tt.want)
+		})
+	}
+
+	got, err := h.Bookings.GetBookingByID(h.AsUser(userID), &mb.GetBookingByIDRequest{BookingId: created.BookingId})
+	if err != nil {
+		t.Fatalf("GetBookingByID: %v", err)
+	}
+	if got.Booking.PaymentStatus != booking.PaymentStatusPending {
+		t.Fatalf("payment status %s after rejected callbacks", got.Booking.PaymentStatus)
+	}
+}
+
+func TestSeatsFreedByFailedOrCancelledBooking(t *testing.T) {
+	tests := []struct {
+		name    string
+		release func(t *testing.T, h *harness.Harness, b *mb.Booking)
+	}{
+		{"payment failed", func(t *testing.T, h *harness.Harness, b *mb.Booking) {
+			failed, err := h.Checkout.PaymentResult(context.Background(), paymentResult(h, b, 900, checkout.PaymentOutcome_FAILED, 300))
+			if err != nil || failed.PaymentStatus != booking.PaymentStatusFailed {
+				t.Fatalf("PaymentResult: %+v, %v", failed, err)
+			}
+		}},
+		{"cancelled", func(t *testing.T, h *harness.Harness, b *mb.Booking) {
+			_, err := h.Bookings.DeleteBookingByBookingID(h.AsUser(userID), &mb.DeleteBookingByIDRequest{BookingId: int32(b.BookingId)})
+			if err != nil {
+				t.Fatalf("DeleteBookingByBookingID: %v", err)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := harness.New(t)
+			created := bookSeats(t, h)
+			tt.release(t, h, created)
+
+			h.UserAdmin.AddUser(&user_admin.User{Id: userID + 1, IsVerified: true})
+			_, err := h.Bookings.CreateBooking(h.AsUser(userID+1), &mb.CreateBookingRequest{
+				UserId:     userID + 1,
+				ShowtimeId: created.ShowtimeId,
+				SeatIds:    []uint32{created.BookingSeats[0].SeatId},
+			})
+			if err != nil {
+				t.Fatalf("CreateBooking for a released seat: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// A query blocked for longer than this didn't see its context end.
+const abortWithin = 5 * time.Second
+
+func TestCancelledContextAbortsQuery(t *testing.T) {
+	h := harness.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	err := h.DB.WithContext(ctx).Exec("SELECT pg_sleep(30)").Error
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("pg_sleep = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > abortWithin {
+		t.Fatalf("query ran for %s after its context was cancelled", elapsed)
+	}
+}
+
+// lockMovie holds a row lock on the movie until the test ends, so that
+// updates to it block.
+func lockMovie(t *testing.T, h *harness.Harness, movieID uint32) {
+	t.Helper()
+	tx := h.DB.Begin()
+	t.Cleanup(func() { tx.Rollback() })
+	if err := tx.Exec("SELECT id FROM movies WHERE id = ? FOR UPDATE", movieID).Error; err != nil {
+		t.Fatalf("lock movie: %v", err)
+	}
+}
+
+// waitUntilUnblocked fails the test if statements are still waiting on a
+// lock after abortWithin. Cancelling a query is asynchronous on the server.
+func waitUntilUnblocked(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	deadline := time.Now().Add(abortWithin)
+	for {
+		var n int
+		err := db.Raw("SELECT count(*) FROM pg_stat_activity WHERE datname = current_database() AND wait_event_type = 'Lock'").Scan(&n).Error
+		if err != nil {
+			t.Fatalf("pg_stat_activity: %v", err)
+		}
+		if n == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d queries are still waiting on a lock", n)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestRepositoryQueryStopsAtDeadline(t *testing.T) {
+	h := harness.New(t)
+	movieID := registerMovie(t, h, "Interstellar")
+	lockMovie(t, h, movieID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := movies.NewRepository(h.DB).UpdateMovie(ctx, movies.Movie{Title: "Tenet"}, int(movieID))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("UpdateMovie = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > abortWithin {
+		t.Fatalf("update ran for %s past its deadline", elapsed)
+	}
+	waitUntilUnblocked(t, h.DB)
+}
+
+func TestRPCDeadlineAbortsQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		rpcTimeout time.Duration
+		callerWait time.Duration
+	}{
+		{name: "caller deadline", rpcTimeout: time.Minute, callerWait: 200 * time.Millisecond},
+		{name: "server default deadline", rpcTimeout: 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := harness.New(t, func(cfg *config.Config) { cfg.RPCTimeout = tt.rpcTimeout })
+			movieID := registerMovie(t, h, "Interstellar")
+			lockMovie(t, h, movieID)
+
+			ctx := h.AsSuperAdmin()
+			if tt.callerWait > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.callerWait)
+				defer cancel()
+			}
+			start := time.Now()
+			_, err := h.Movies.UpdateMovie(ctx, &mb.UpdateMovieRequest{MovieId: movieID, Title: "Tenet"})
+			wantCode(t, err, codes.DeadlineExceeded)
+			if elapsed := time.Since(start); elapsed > abortWithin {
+				t.Fatalf("UpdateMovie returned after %s", elapsed)
+			}
+			// The server side query has to stop too, not just the call.
+			waitUntilUnblocked(t, h.DB)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const ownerID = 7
+
+// cinema is a theater owned by ownerID with one 2D screen of 20 seats and
+// no movies or shows.
+const cinema = `
+theater_types: [Multiplex]
+screen_types: [Standard]
+seat_categories: [Silver]
+theaters:
+  - name: Harness Cinema
+    place: Edappally
+    city: Kochi
+    district: Ernakulam
+    state: Kerala
+    owner_id: 7
+    theater_type: Multiplex
+    screens:
+      - number: 1
+        seat_capacity: 20
+        screen_type: Standard
+        columns: 10
+        rows:
+          - {from: A, to: B, category: Silver, price: 150}
+`
+
+// showDay is far enough ahead that no test runs into the booking cut-off.
+var showDay = time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+func registerMovie(t *testing.T, h *harness.Harness, title string) uint32 {
+	t.Helper()
+	resp, err := h.Movies.RegisterMovie(h.AsSuperAdmin(), &mb.RegisterMovieRequest{
+		Title:       title,
+		Description: "A movie registered by the end to end tests.",
+		Duration:    120,
+		Genre:       "Drama",
+		ReleaseDate: "2024-01-01",
+		Rating:      7.5,
+		Language:    "English",
+	})
+	if err != nil {
+		t.Fatalf("RegisterMovie: %v", err)
+	}
+	return resp.MovieId
+}
+
+func screenID(t *testing.T, h *harness.Harness) uint32 {
+	t.Helper()
+	theaters, err := h.Theatres.GetTheaterByName(h.AsUser(1), &mb.GetTheaterByNameRequest{Name: "Harness Cinema"})
+	if err != nil || len(theaters.Theater) != 1 {
+		t.Fatalf("GetTheaterByName: %v, %d theaters", err, len(theaters.GetTheater()))
+	}
+	screen, err := h.Theatres.GetTheaterScreenByNumber(h.AsUser(1), &mb.GetTheaterScreenByNumberRequest{
+		TheaterID:    theaters.Theater[0].TheaterId,
+		ScreenNumber: 1,
+	})
+	if err != nil {
+		t.Fatalf("GetTheaterScreenByNumber: %v", err)
+	}
+	return screen.TheaterScreen.ID
+}
+
+// addShowtime schedules movieID at 18:30 on showDay and returns the show.
+func addShowtime(t *testing.T, h *harness.Harness, movieID, screenID uint32) *mb.Showtime {
+	t.Helper()
+	showTime := showDay.Add(18*time.Hour + 30*time.Minute)
+	_, err := h.Theatres.AddShowtime(h.AsAdmin(ownerID), &mb.AddShowtimeRequest{
+		OwnerId: ownerID,
+		Showtime: &mb.Showtime{
+			MovieId:  int32(movieID),
+			ScreenId: int32(screenID),
+			ShowDate: timestamppb.New(showDay),
+			ShowTime: timestamppb.New(showTime),
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddShowtime: %v", err)
+	}
+	resp, err := h.Theatres.GetShowtimeByDetails(h.AsUser(1), &mb.GetShowtimeByDetailsRequest{
+		MovieId:  int32(movieID),
+		ScreenId: int32(screenID),
+		ShowDate: timestamppb.New(showDay),
+		ShowTime: timestamppb.New(showTime),
+	})
+	if err != nil {
+		t.Fatalf("GetShowtimeByDetails: %v", err)
+	}
+	return resp.Showtime
+}
+
+// seatIDs returns the ids of the named seats on the screen.
+func seatIDs(t *testing.T, h *harness.Harness, screenID uint32, numbers ...string) []uint32 {
+	t.Helper()
+	resp, err := h.Theatres.GetSeatsByScreenID(h.AsUser(1), &mb.GetSeatsByScreenIDRequest{ScreenId: int32(screenID)})
+	if err != nil {
+		t.Fatalf("GetSeatsByScreenID: %v", err)
+	}
+	byNumber := map[string]uint32{}
+	for _, seat := range resp.Seats {
+		byNumber[seat.SeatNumber] = uint32(seat.Id)
+	}
+	ids := make([]uint32, 0, len(numbers))
+	for _, number := range numbers {
+		id, ok := byNumber[number]
+		if !ok {
+			t.Fatalf("no seat %s on screen %d", number, screenID)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func wantCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Fatalf("got %v (%v), want %v", got, err, want)
+	}
+}
@@ -0,0 +1,11 @@
+// Package e2e drives the service through its gRPC clients on top of the
+// integration harness.
+package e2e
+
+import (
+	"testing"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+)
+
+func TestMain(m *testing.M) { harness.Main(m) }
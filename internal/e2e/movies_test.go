@@ -0,0 +1,84 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRegisterMovie(t *testing.T) {
+	h := harness.New(t)
+
+	movieID := registerMovie(t, h, "Interstellar")
+	resp, err := h.Movies.GetMovieDetailsByID(h.AsUser(1), &mb.GetMovieDetailsRequest{MovieId: movieID})
+	if err != nil {
+		t.Fatalf("GetMovieDetailsByID: %v", err)
+	}
+	if resp.Movie.Title != "Interstellar" || resp.Movie.Language != "English" || resp.Movie.ReleaseDate == "" {
+		t.Fatalf("unexpected movie %+v", resp.Movie)
+	}
+
+	_, err = h.Movies.RegisterMovie(h.AsAdmin(ownerID), &mb.RegisterMovieRequest{
+		Title:       "Tenet",
+		Duration:    150,
+		ReleaseDate: "2020-08-26",
+		Language:    "English",
+	})
+	wantCode(t, err, codes.PermissionDenied)
+}
+
+func TestRegisterMovieRequiresToken(t *testing.T) {
+	h := harness.New(t)
+
+	_, err := h.Movies.RegisterMovie(context.Background(), &mb.RegisterMovieRequest{Title: "Tenet", ReleaseDate: "2020-08-26"})
+	wantCode(t, err, codes.Unauthenticated)
+}
+
+func TestSearchMoviesTextWithFilters(t *testing.T) {
+	h := harness.New(t)
+
+	ids := map[string]uint32{}
+	for _, movie := range []*mb.RegisterMovieRequest{
+		{Title: "Vault", Description: "A daring bank robbery goes wrong.", Genre: "Thriller", Rating: 8, Language: "English"},
+		{Title: "Vault Hindi", Description: "A daring bank robbery goes wrong.", Genre: "Thriller", Rating: 8, Language: "Hindi"},
+		{Title: "Vault Low", Description: "A daring bank robbery goes wrong.", Genre: "Thriller", Rating: 4, Language: "English"},
+		{Title: "Vault Deleted", Description: "A daring bank robbery goes wrong.", Genre: "Thriller", Rating: 8, Language: "English"},
+		{Title: "Orchard", Description: "Two sisters run a farm.", Genre: "Drama", Rating: 9, Language: "English"},
+	} {
+		movie.Duration = 110
+		movie.ReleaseDate = "2024-01-01"
+		resp, err := h.Movies.RegisterMovie(h.AsSuperAdmin(), movie)
+		if err != nil {
+			t.Fatalf("RegisterMovie %s: %v", movie.Title, err)
+		}
+		ids[movie.Title] = resp.MovieId
+	}
+	if _, err := h.Movies.DeleteMovie(h.AsSuperAdmin(), &mb.DeleteMovieRequest{MovieId: ids["Vault Deleted"]}); err != nil {
+		t.Fatalf("DeleteMovie: %v", err)
+	}
+
+	minRating := 7.0
+	for _, query := range []string{"vault", "thriler", "robbry"} {
+		t.Run(query, func(t *testing.T) {
+			resp, err := h.Catalog.SearchMovies(context.Background(), &catalog.SearchMoviesRequest{
+				Query:     query,
+				Languages: []string{"english"},
+				MinRating: &minRating,
+			})
+			if err != nil {
+				t.Fatalf("SearchMovies: %v", err)
+			}
+			if len(resp.Results) != 1 || resp.Results[0].MovieId != ids["Vault"] {
+				titles := make([]string, len(resp.Results))
+				for i, result := range resp.Results {
+					titles[i] = result.Title
+				}
+				t.Fatalf("got %v, want only Vault", titles)
+			}
+		})
+	}
+}
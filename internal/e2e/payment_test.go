@@ -0,0 +1,167 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/checkout"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func paymentStatus(t *testing.T, h *harness.Harness, bookingID uint32) string {
+	t.Helper()
+	got, err := h.Bookings.GetBookingByID(h.AsUser(userID), &mb.GetBookingByIDRequest{BookingId: bookingID})
+	if err != nil {
+		t.Fatalf("GetBookingByID: %v", err)
+	}
+	return got.Booking.PaymentStatus
+}
+
+func startPayment(h *harness.Harness, bookingID uint32) error {
+	_, err := h.Checkout.StartPayment(h.AsUser(userID), &checkout.StartPaymentRequest{BookingId: bookingID, PaymentMethodId: 1})
+	return err
+}
+
+func TestStartPaymentFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus string
+	}{
+		{"rejected", status.Error(codes.InvalidArgument, "unknown payment method"), booking.PaymentStatusPending},
+		{"timed out", status.Error(codes.DeadlineExceeded, "context deadline exceeded"), booking.PaymentStatusProcessing},
+		{"connection dropped", status.Error(codes.Unavailable, "connection reset by peer"), booking.PaymentStatusProcessing},
+		{"internal error", status.Error(codes.Internal, "gateway error"), booking.PaymentStatusProcessing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := harness.New(t)
+			h.Payment.ProcessPaymentFunc = func(ctx context.Context, req *payment.ProcessPaymentRequest) (*payment.ProcessPaymentResponse, error) {
+				return nil, tt.err
+			}
+			created := bookSeats(t, h)
+
+			wantCode(t, startPayment(h, created.BookingId), status.Code(tt.err))
+			if got := paymentStatus(t, h, created.BookingId); got != tt.wantStatus {
+				t.Fatalf("payment status %s, want %s", got, tt.wantStatus)
+			}
+			if tt.wantStatus != booking.PaymentStatusProcessing {
+				return
+			}
+			// Trying again must not charge a second time.
+			startPayment(h, created.BookingId)
+			if calls := len(h.Payment.Calls()); calls != 1 {
+				t.Fatalf("payment service got %d calls, want 1", calls)
+			}
+			// The callback still settles the payment.
+			paid, err := h.Checkout.PaymentResult(context.Background(), paymentResult(h, created, 901, checkout.PaymentOutcome_SUCCEEDED, 300))
+			if err != nil || !paid.Applied || paid.PaymentStatus != booking.PaymentStatusSuccess {
+				t.Fatalf("PaymentResult: %+v, %v", paid, err)
+			}
+		})
+	}
+}
+
+func TestStartPaymentBreakerOpen(t *testing.T) {
+	h := harness.New(t, func(cfg *config.Config) { cfg.PaymentBreakerThreshold = 1 })
+	h.Payment.ProcessPaymentFunc = func(ctx context.Context, req *payment.ProcessPaymentRequest) (*payment.ProcessPaymentResponse, error) {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	first := bookSeats(t, h)
+	wantCode(t, startPayment(h, first.BookingId), codes.Unavailable)
+
+	second, err := h.Bookings.CreateBooking(h.AsUser(userID), &mb.CreateBookingRequest{
+		UserId:     userID,
+		ShowtimeId: first.ShowtimeId,
+		SeatIds:    seatIDs(t, h, screenID(t, h), "B1"),
+	})
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+	// The open breaker never sends the payment, so the booking is released.
+	wantCode(t, startPayment(h, second.Booking.BookingId), codes.Unavailable)
+	if got := paymentStatus(t, h, second.Booking.BookingId); got != booking.PaymentStatusPending {
+		t.Fatalf("payment status %s, want %s", got, booking.PaymentStatusPending)
+	}
+	if got := paymentStatus(t, h, first.BookingId); got != booking.PaymentStatusProcessing {
+		t.Fatalf("payment status %s, want %s", got, booking.PaymentStatusProcessing)
+	}
+	if calls := len(h.Payment.Calls()); calls != 1 {
+		t.Fatalf("payment service got %d calls, want 1", calls)
+	}
+}
+
+func TestReconcilePayments(t *testing.T) {
+	t.Run("unconfirmed", func(t *testing.T) {
+		h := harness.New(t)
+		h.Payment.ProcessPaymentFunc = func(ctx context.Context, req *payment.ProcessPaymentRequest) (*payment.ProcessPaymentResponse, error) {
+			return nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+		}
+		created := bookSeats(t, h)
+		startPayment(h, created.BookingId)
+
+		summary, err := h.Services.Booking.ReconcilePayments(context.Background(), 0)
+		if err != nil {
+			t.Fatalf("ReconcilePayments: %v", err)
+		}
+		if summary != (booking.ReconcileSummary{Checked: 1, Unconfirmed: 1}) {
+			t.Fatalf("unexpected summary %+v", summary)
+		}
+		if got := paymentStatus(t, h, created.BookingId); got != booking.PaymentStatusProcessing {
+			t.Fatalf("payment status %s, want %s", got, booking.PaymentStatusProcessing)
+		}
+	})
+
+	t.Run("settled", func(t *testing.T) {
+		h := harness.New(t)
+		h.Payment.ProcessPaymentFunc = func(ctx context.Context, req *payment.ProcessPaymentRequest) (*payment.ProcessPaymentResponse, error) {
+			return &payment.ProcessPaymentResponse{Transaction: &payment.Transaction{TransactionId: 900, OrderId: "order-1", Status: "Pending"}}, nil
+		}
+		h.Payment.GetTransactionStatusFunc = func(ctx context.Context, req *payment.GetTransactionStatusRequest) (*payment.GetTransactionStatusResponse, error) {
+			return &payment.GetTransactionStatusResponse{TransactionId: req.TransactionId, Status: "Success", Amount: 300}, nil
+		}
+		created := bookSeats(t, h)
+		if err := startPayment(h, created.BookingId); err != nil {
+			t.Fatalf("StartPayment: %v", err)
+		}
+
+		summary, err := h.Services.Booking.ReconcilePayments(context.Background(), 0)
+		if err != nil {
+			t.Fatalf("ReconcilePayments: %v", err)
+		}
+		if summary != (booking.ReconcileSummary{Checked: 1, Paid: 1}) {
+			t.Fatalf("unexpected summary %+v", summary)
+		}
+		if got := paymentStatus(t, h, created.BookingId); got != booking.PaymentStatusSuccess {
+			t.Fatalf("payment status %s, want %s", got, booking.PaymentStatusSuccess)
+		}
+	})
+}
+
+func TestPaymentReportFlagsUnconfirmedPayments(t *testing.T) {
+	h := harness.New(t)
+	h.Payment.ProcessPaymentFunc = func(ctx context.Context, req *payment.ProcessPaymentRequest) (*payment.ProcessPaymentResponse, error) {
+		return nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+	}
+	created := bookSeats(t, h)
+	startPayment(h, created.BookingId)
+
+	now := time.Now()
+	report, err := h.Services.Booking.PaymentReport(context.Background(), now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("PaymentReport: %v", err)
+	}
+	if report.Checked != 1 || len(report.Mismatches) != 1 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if m := report.Mismatches[0]; m.BookingID != uint(created.BookingId) || m.Kind != booking.MismatchUnconfirmed {
+		t.Fatalf("unexpected mismatch %+v", m)
+	}
+}
@@ -0,0 +1,79 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	mb "github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/inter-communication/user_admin"
+	"github.com/aparnasukesh/movies-booking-svc/internal/harness"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAddShowtime(t *testing.T) {
+	h := harness.New(t)
+	h.Load(cinema)
+	movieID := registerMovie(t, h, "Interstellar")
+	screen := screenID(t, h)
+
+	show := addShowtime(t, h, movieID, screen)
+	if show.MovieId != int32(movieID) || show.ScreenId != int32(screen) {
+		t.Fatalf("unexpected showtime %+v", show)
+	}
+	if got := show.ShowTime.AsTime(); !got.Equal(showDay.Add(18*time.Hour + 30*time.Minute)) {
+		t.Fatalf("show time %v", got)
+	}
+
+	// Scheduling the same show twice is rejected.
+	_, err := h.Theatres.AddShowtime(h.AsAdmin(ownerID), &mb.AddShowtimeRequest{
+		OwnerId: ownerID,
+		Showtime: &mb.Showtime{
+			MovieId:  show.MovieId,
+			ScreenId: show.ScreenId,
+			ShowDate: show.ShowDate,
+			ShowTime: show.ShowTime,
+		},
+	})
+	wantCode(t, err, codes.AlreadyExists)
+}
+
+func TestAddShowtimeOtherOwner(t *testing.T) {
+	h := harness.New(t)
+	h.Load(cinema)
+	h.UserAdmin.AddAdmin(&user_admin.Admin{Id: 8, IsVerified: true})
+	movieID := registerMovie(t, h, "Interstellar")
+	screen := screenID(t, h)
+
+	_, err := h.Theatres.AddShowtime(h.AsAdmin(8), &mb.AddShowtimeRequest{
+		OwnerId: 8,
+		Showtime: &mb.Showtime{
+			MovieId:  int32(movieID),
+			ScreenId: int32(screen),
+			ShowDate: timestamppb.New(showDay),
+			ShowTime: timestamppb.New(showDay.Add(20 * time.Hour)),
+		},
+	})
+	wantCode(t, err, codes.PermissionDenied)
+}
+
+func TestAddTheaterUnverifiedOwner(t *testing.T) {
+	h := harness.New(t)
+	h.Load(cinema)
+	h.UserAdmin.AddAdmin(&user_admin.Admin{Id: 9, IsVerified: false})
+	theaters, err := h.Theatres.GetTheaterByName(h.AsUser(1), &mb.GetTheaterByNameRequest{Name: "Harness Cinema"})
+	if err != nil {
+		t.Fatalf("GetTheaterByName: %v", err)
+	}
+
+	_, err = h.Theatres.AddTheater(h.AsAdmin(9), &mb.AddTheaterRequest{
+		Name:            "Unverified Cinema",
+		Place:           "MG Road",
+		City:            "Kochi",
+		District:        "Ernakulam",
+		State:           "Kerala",
+		NumberOfScreens: 1,
+		TheaterTypeId:   theaters.Theater[0].TheaterTypeId,
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+}
@@ -0,0 +1,351 @@
+// Package harness runs the whole service in process for end to end tests:
+// a fresh Postgres database with migrations applied, miniredis, a fake
+// payment service, a fake user admin service, and the real di graph serving gRPC over bufconn.
+//
+// Packages using it should hand their TestMain to Main so the shared
+// Postgres server is stopped when the tests finish:
+//
+//	func TestMain(m *testing.M) { harness.Main(m) }
+//
+// Embedded Postgres downloads its binaries on first use and refuses to run
+// as root; set HARNESS_POSTGRES_URL to use an existing server instead. When
+// no server can be started the tests are skipped, unless
+// HARNESS_REQUIRE_POSTGRES is set, as it should be in CI.
+package harness
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/aparnasukesh/inter-communication/movie_booking"
+	"github.com/aparnasukesh/inter-communication/notification"
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/inter-communication/user_admin"
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"github.com/aparnasukesh/movies-booking-svc/internal/di"
+	"github.com/aparnasukesh/movies-booking-svc/internal/seed"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/logger"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/checkout"
+	rbacpb "github.com/aparnasukesh/movies-booking-svc/pkg/pb/rbac"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/scheduling"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/pb/ticketing"
+	sql "github.com/aparnasukesh/movies-booking-svc/pkg/sql"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+)
+
+const bufSize = 1 << 20
+
+// Harness is a running service instance. The clients talk to it through the
+// full interceptor chain, so calls need an identity from As, AsUser,
+// AsAdmin, AsSuperAdmin or AsService unless the method is public.
+type Harness struct {
+	Config    config.Config
+	DB        *gorm.DB
+	Redis     *miniredis.Miniredis
+	Payment   *FakePayment
+	UserAdmin *FakeUserAdmin
+	Services  *di.Services
+
+	Movies     movie_booking.MovieServiceClient
+	Catalog    catalog.CatalogServiceClient
+	Theatres   movie_booking.TheatreServiceClient
+	Scheduling scheduling.SchedulingServiceClient
+	Bookings   movie_booking.BookingServiceClient
+	Tickets    ticketing.TicketServiceClient
+	Checkout   checkout.CheckoutServiceClient
+	Staff      rbacpb.StaffServiceClient
+	Health     healthpb.HealthClient
+
+	t      testing.TB
+	logger *slog.Logger
+}
+
+// Option adjusts the config before the service is built.
+type Option func(*config.Config)
+
+// Main runs the tests and stops the shared Postgres server afterwards.
+func Main(m *testing.M) {
+	code := m.Run()
+	if err := Shutdown(); err != nil {
+		slog.Error("failed to stop embedded postgres", "error", err)
+	}
+	os.Exit(code)
+}
+
+// New starts a service instance backed by its own database. Everything is
+// torn down when the test finishes.
+func New(t testing.TB, opts ...Option) *Harness {
+	t.Helper()
+
+	if _, err := postgresServer(); err != nil {
+		if os.Getenv(RequirePostgresEnv) == "" {
+			t.Skipf("harness: no postgres server, set %s to fail instead: %v", RequirePostgresEnv, err)
+		}
+		t.Fatalf("harness: %v", err)
+	}
+	dbName, dbURL, dropDB, err := createDatabase()
+	if err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := dropDB(); err != nil {
+			t.Logf("harness: failed to drop database %s: %v", dbName, err)
+		}
+	})
+
+	cfg := config.Config{
+		DBName:              dbName,
+		TicketSigningKey:    randomSigningKey(t),
+		CheckInOpensBefore:  45 * time.Minute,
+		CheckInClosesAfter:  30 * time.Minute,
+		JWTSecret:           "harness-secret",
+		RPCTimeout:          10 * time.Second,
+		LogLevel:            "debug",
+		LogFormat:           "text",
+		HealthCheckInterval: time.Second,
+		AgeRatingPolicy:     "block",
+		// Uncached so changes to the fake user admin show up at once.
+		UserAdminCacheTTL:       0,
+		PaymentRetryAttempts:    3,
+		PaymentRetryBackoff:     10 * time.Millisecond,
+		PaymentRetryMaxBackoff:  50 * time.Millisecond,
+		PaymentBreakerThreshold: 5,
+		PaymentBreakerCooldown:  time.Second,
+		// The reconciler isn't started; tests call ReconcilePayments.
+		PaymentReconcileInterval: time.Minute,
+		PaymentStaleAfter:        time.Minute,
+		PaymentCallbacks:         true,
+		PaymentCallbackSecret:    "harness-callback-secret",
+		PaymentCallbackMaxAge:    time.Minute,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	appLogger, err := logger.NewWithWriter(testWriter{t}, cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+
+	db, err := openGorm(dbURL)
+	if err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	migrator, err := sql.NewMigrator(sqlDB, appLogger)
+	if err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+	if err := migrator.Up(context.Background(), 0); err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+
+	redisServer := miniredis.RunT(t)
+	redisClient := goredis.NewClient(&goredis.Options{Addr: redisServer.Addr()})
+	t.Cleanup(func() { redisClient.Close() })
+
+	fakePayment := &FakePayment{}
+	paymentConn := serveBufconn(t, func(s *grpc.Server) {
+		payment.RegisterPaymentServiceServer(s, fakePayment)
+	})
+
+	fakeUserAdmin := &FakeUserAdmin{}
+	userAdminConn := serveBufconn(t, func(s *grpc.Server) {
+		user_admin.RegisterSuperAdminServiceServer(s, fakeUserAdmin)
+	})
+
+	notificationConn := serveBufconn(t, func(s *grpc.Server) {
+		notification.RegisterEmailServiceServer(s, notification.UnimplementedEmailServiceServer{})
+	})
+
+	lis := bufconn.Listen(bufSize)
+	app, err := di.NewApplication(cfg, appLogger, di.Infrastructure{
+		DB:                 db,
+		Redis:              redisClient,
+		PaymentClient:      payment.NewPaymentServiceClient(paymentConn),
+		PaymentConn:        paymentConn,
+		UserAdminClient:    user_admin.NewSuperAdminServiceClient(userAdminConn),
+		UserAdminConn:      userAdminConn,
+		NotificationClient: notification.NewEmailServiceClient(notificationConn),
+		NotificationConn:   notificationConn,
+	}, lis)
+	if err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go app.GrpcServer.Serve()
+	go app.HealthChecker.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		stopCtx, stop := context.WithTimeout(context.Background(), 5*time.Second)
+		defer stop()
+		app.GrpcServer.Stop(stopCtx)
+	})
+	conn := dialBufconn(t, lis)
+
+	return &Harness{
+		Config:     cfg,
+		DB:         db,
+		Redis:      redisServer,
+		Payment:    fakePayment,
+		UserAdmin:  fakeUserAdmin,
+		Services:   app.Services,
+		Movies:     movie_booking.NewMovieServiceClient(conn),
+		Catalog:    catalog.NewCatalogServiceClient(conn),
+		Theatres:   movie_booking.NewTheatreServiceClient(conn),
+		Scheduling: scheduling.NewSchedulingServiceClient(conn),
+		Bookings:   movie_booking.NewBookingServiceClient(conn),
+		Tickets:    ticketing.NewTicketServiceClient(conn),
+		Checkout:   checkout.NewCheckoutServiceClient(conn),
+		Staff:      rbacpb.NewStaffServiceClient(conn),
+		Health:     healthpb.NewHealthClient(conn),
+		t:          t,
+		logger:     appLogger,
+	}
+}
+
+// Token signs an access token for identity the way the auth service does.
+func (h *Harness) Token(identity auth.Identity) string {
+	h.t.Helper()
+	claims := auth.Claims{
+		UserID: int(identity.UserID),
+		Role:   string(identity.Role),
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(h.Config.JWTSecret))
+	if err != nil {
+		h.t.Fatalf("harness: failed to sign token: %v", err)
+	}
+	return token
+}
+
+// As returns a context whose outgoing calls carry a token for identity.
+func (h *Harness) As(identity auth.Identity) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+h.Token(identity))
+}
+
+func (h *Harness) AsUser(userID uint) context.Context {
+	return h.As(auth.Identity{UserID: userID, Role: auth.RoleUser})
+}
+
+func (h *Harness) AsAdmin(adminID uint) context.Context {
+	return h.As(auth.Identity{UserID: adminID, Role: auth.RoleAdmin})
+}
+
+func (h *Harness) AsSuperAdmin() context.Context {
+	return h.As(auth.Identity{UserID: 1, Role: auth.RoleSuperAdmin})
+}
+
+func (h *Harness) AsService() context.Context {
+	return h.As(auth.Identity{UserID: 1, Role: auth.RoleService})
+}
+
+// Load applies a YAML or JSON fixture through the service layer. See
+// seed.Fixture for the format.
+func (h *Harness) Load(fixture string) {
+	h.t.Helper()
+	parsed, err := seed.Parse([]byte(fixture))
+	if err != nil {
+		h.t.Fatalf("harness: %v", err)
+	}
+	h.load(parsed)
+}
+
+// LoadFile applies a fixture file, e.g. fixtures/dev.yaml.
+func (h *Harness) LoadFile(path string) {
+	h.t.Helper()
+	parsed, err := seed.LoadFile(path)
+	if err != nil {
+		h.t.Fatalf("harness: %v", err)
+	}
+	h.load(parsed)
+}
+
+// load registers the fixture's theater owners and booking users with the
+// fake user admin service, verified, unless the test added them already.
+func (h *Harness) load(fixture *seed.Fixture) {
+	h.t.Helper()
+	for _, theater := range fixture.Theaters {
+		if id := int32(theater.OwnerID); !h.UserAdmin.hasAdmin(id) {
+			h.UserAdmin.AddAdmin(&user_admin.Admin{Id: id, IsVerified: true})
+		}
+	}
+	for _, booking := range fixture.Bookings {
+		if id := int32(booking.UserID); !h.UserAdmin.hasUser(id) {
+			h.UserAdmin.AddUser(&user_admin.User{Id: id, IsVerified: true})
+		}
+	}
+	loader := seed.NewLoader(h.Services.Movies, h.Services.Theatres, h.Services.Booking, h.logger)
+	if err := loader.Load(context.Background(), fixture); err != nil {
+		h.t.Fatalf("harness: failed to load fixture: %v", err)
+	}
+}
+
+// serveBufconn runs a gRPC server with the given services in memory and
+// returns a client connection to it.
+func serveBufconn(t testing.TB, register func(s *grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return dialBufconn(t, lis)
+}
+
+func dialBufconn(t testing.TB, lis *bufconn.Listener) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("harness: failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func randomSigningKey(t testing.TB) string {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatalf("harness: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(seed)
+}
+
+// testWriter sends service logs to the test log so they only show up for
+// failing or verbose tests.
+type testWriter struct {
+	t testing.TB
+}
+
+func (w testWriter) Write(p []byte) (int, error) {
+	w.t.Log(strings.TrimRight(string(p), "\n"))
+	return len(p), nil
+}
@@ -0,0 +1,73 @@
+package harness
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/aparnasukesh/inter-communication/payment"
+)
+
+// FakePayment is an in-memory payment service. Every call is recorded, and
+// tests can override any method by setting the matching func field.
+type FakePayment struct {
+	pb.UnimplementedPaymentServiceServer
+
+	ProcessPaymentFunc       func(ctx context.Context, req *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error)
+	GetTransactionStatusFunc func(ctx context.Context, req *pb.GetTransactionStatusRequest) (*pb.GetTransactionStatusResponse, error)
+	PaymentSuccessFunc       func(ctx context.Context, req *pb.PaymentSuccessRequest) (*pb.PaymentSuccessResponse, error)
+	PaymentFailureFunc       func(ctx context.Context, req *pb.PaymentFailureRequest) (*pb.PaymentFailureResponse, error)
+
+	mu    sync.Mutex
+	calls []interface{}
+}
+
+// Calls returns the requests received so far, in order.
+func (f *FakePayment) Calls() []interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]interface{}(nil), f.calls...)
+}
+
+func (f *FakePayment) record(req interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, req)
+}
+
+func (f *FakePayment) ProcessPayment(ctx context.Context, req *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error) {
+	f.record(req)
+	if f.ProcessPaymentFunc != nil {
+		return f.ProcessPaymentFunc(ctx, req)
+	}
+	return &pb.ProcessPaymentResponse{Transaction: &pb.Transaction{
+		BookingId:       req.BookingId,
+		UserId:          req.UserId,
+		PaymentMethodId: req.PaymentMethodId,
+		Amount:          req.Amount,
+		Status:          "Pending",
+	}}, nil
+}
+
+func (f *FakePayment) GetTransactionStatus(ctx context.Context, req *pb.GetTransactionStatusRequest) (*pb.GetTransactionStatusResponse, error) {
+	f.record(req)
+	if f.GetTransactionStatusFunc != nil {
+		return f.GetTransactionStatusFunc(ctx, req)
+	}
+	return &pb.GetTransactionStatusResponse{TransactionId: req.TransactionId, Status: "Pending"}, nil
+}
+
+func (f *FakePayment) PaymentSuccess(ctx context.Context, req *pb.PaymentSuccessRequest) (*pb.PaymentSuccessResponse, error) {
+	f.record(req)
+	if f.PaymentSuccessFunc != nil {
+		return f.PaymentSuccessFunc(ctx, req)
+	}
+	return &pb.PaymentSuccessResponse{}, nil
+}
+
+func (f *FakePayment) PaymentFailure(ctx context.Context, req *pb.PaymentFailureRequest) (*pb.PaymentFailureResponse, error) {
+	f.record(req)
+	if f.PaymentFailureFunc != nil {
+		return f.PaymentFailureFunc(ctx, req)
+	}
+	return &pb.PaymentFailureResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package harness
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/aparnasukesh/inter-communication/user_admin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FakeUserAdmin serves the users and theater admins added with AddUser and
+// AddAdmin. Tests can override a lookup by setting the matching func field.
+type FakeUserAdmin struct {
+	pb.UnimplementedSuperAdminServiceServer
+
+	GetUserByIDFunc  func(ctx context.Context, req *pb.GetUserByIdRequest) (*pb.GetUserByIdResponse, error)
+	GetAdminByIDFunc func(ctx context.Context, req *pb.GetAdminByIdRequest) (*pb.GetAdminByIdResponse, error)
+
+	mu     sync.Mutex
+	users  map[int32]*pb.User
+	admins map[int32]*pb.Admin
+}
+
+// AddUser makes user visible to the service, replacing any user with the
+// same id.
+func (f *FakeUserAdmin) AddUser(user *pb.User) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.users == nil {
+		f.users = make(map[int32]*pb.User)
+	}
+	f.users[user.Id] = user
+}
+
+// AddAdmin makes a theater admin visible to the service. IsVerified marks
+// the admin as approved.
+func (f *FakeUserAdmin) AddAdmin(admin *pb.Admin) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.admins == nil {
+		f.admins = make(map[int32]*pb.Admin)
+	}
+	f.admins[admin.Id] = admin
+}
+
+func (f *FakeUserAdmin) hasUser(id int32) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.users[id]
+	return ok
+}
+
+func (f *FakeUserAdmin) hasAdmin(id int32) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.admins[id]
+	return ok
+}
+
+func (f *FakeUserAdmin) GetUserByID(ctx context.Context, req *pb.GetUserByIdRequest) (*pb.GetUserByIdResponse, error) {
+	if f.GetUserByIDFunc != nil {
+		return f.GetUserByIDFunc(ctx, req)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	user, ok := f.users[req.UserId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", req.UserId)
+	}
+	return &pb.GetUserByIdResponse{User: user}, nil
+}
+
+func (f *FakeUserAdmin) GetAdminByID(ctx context.Context, req *pb.GetAdminByIdRequest) (*pb.GetAdminByIdResponse, error) {
+	if f.GetAdminByIDFunc != nil {
+		return f.GetAdminByIDFunc(ctx, req)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	admin, ok := f.admins[req.AdminId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "admin %d not found", req.AdminId)
+	}
+	return &pb.GetAdminByIdResponse{Admin: admin}, nil
+}
@@ -0,0 +1,172 @@
+// Package lifecycle starts the service's servers and workers and tears them
+// down in order when the process is asked to stop.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+type server struct {
+	name  string
+	serve func() error
+	stop  func(ctx context.Context) error
+}
+
+type worker struct {
+	name string
+	run  func(ctx context.Context)
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Manager runs servers and background workers until a signal arrives or a
+// server fails, then shuts everything down in this order:
+//
+//  1. shutdown hooks (e.g. health reporting NOT_SERVING)
+//  2. servers, given ShutdownTimeout to drain in-flight calls
+//  3. background workers
+//  4. resources, in reverse order of registration like deferred calls
+type Manager struct {
+	logger          *slog.Logger
+	shutdownTimeout time.Duration
+	hooks           []func()
+	servers         []server
+	workers         []worker
+	closers         []closer
+	closeOnce       sync.Once
+	closeErr        error
+}
+
+func New(logger *slog.Logger, shutdownTimeout time.Duration) *Manager {
+	return &Manager{
+		logger:          logger,
+		shutdownTimeout: shutdownTimeout,
+	}
+}
+
+// OnShutdown registers a hook that runs as soon as shutdown starts.
+func (m *Manager) OnShutdown(hook func()) {
+	m.hooks = append(m.hooks, hook)
+}
+
+// AddServer registers a blocking serve func and the func that stops it. Stop
+// must return once in-flight work is done or ctx expires.
+func (m *Manager) AddServer(name string, serve func() error, stop func(ctx context.Context) error) {
+	m.servers = append(m.servers, server{name: name, serve: serve, stop: stop})
+}
+
+// AddWorker registers a background worker. Its context is cancelled after
+// the servers have stopped and Run waits for it to return.
+func (m *Manager) AddWorker(name string, run func(ctx context.Context)) {
+	m.workers = append(m.workers, worker{name: name, run: run})
+}
+
+// AddCloser registers a resource to release once servers and workers have
+// stopped. Register resources right after opening them; they are closed
+// last-opened first.
+func (m *Manager) AddCloser(name string, close func() error) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run blocks until SIGINT/SIGTERM, ctx is cancelled or a server fails, and
+// returns after everything was shut down.
+func (m *Manager) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	workerCtx, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+	var workers sync.WaitGroup
+	for _, w := range m.workers {
+		workers.Add(1)
+		go func(w worker) {
+			defer workers.Done()
+			w.run(workerCtx)
+			m.logger.Info("worker stopped", "worker", w.name)
+		}(w)
+	}
+
+	serveErrs := make(chan error, len(m.servers))
+	for _, s := range m.servers {
+		go func(s server) {
+			if err := s.serve(); err != nil {
+				serveErrs <- fmt.Errorf("%s: %w", s.name, err)
+				return
+			}
+			serveErrs <- nil
+		}(s)
+	}
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+		m.logger.Info("shutdown requested")
+	case runErr = <-serveErrs:
+		m.logger.Error("server stopped unexpectedly, shutting down", "error", runErr)
+	}
+	stop()
+
+	for _, hook := range m.hooks {
+		hook()
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), m.shutdownTimeout)
+	defer cancel()
+	var errs []error
+	if runErr != nil {
+		errs = append(errs, runErr)
+	}
+	var servers sync.WaitGroup
+	var mu sync.Mutex
+	for _, s := range m.servers {
+		servers.Add(1)
+		go func(s server) {
+			defer servers.Done()
+			if err := s.stop(shutdownCtx); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("stopping %s: %w", s.name, err))
+				mu.Unlock()
+				return
+			}
+			m.logger.Info("server stopped", "server", s.name)
+		}(s)
+	}
+	servers.Wait()
+
+	cancelWorkers()
+	workers.Wait()
+
+	if err := m.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Close releases the registered resources. It is safe to call on its own
+// when startup fails before Run.
+func (m *Manager) Close() error {
+	m.closeOnce.Do(func() {
+		var errs []error
+		for i := len(m.closers) - 1; i >= 0; i-- {
+			c := m.closers[i]
+			if err := c.close(); err != nil {
+				errs = append(errs, fmt.Errorf("closing %s: %w", c.name, err))
+				continue
+			}
+			m.logger.Info("closed", "resource", c.name)
+		}
+		m.closeErr = errors.Join(errs...)
+	})
+	return m.closeErr
+}
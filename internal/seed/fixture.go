@@ -0,0 +1,99 @@
+package seed
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture describes a data set in YAML or JSON. Records refer to each other
+// by their natural keys (names, screen numbers, seat numbers) rather than
+// database ids so the same file can be loaded into any database.
+type Fixture struct {
+	TheaterTypes   []string      `yaml:"theater_types"`
+	ScreenTypes    []string      `yaml:"screen_types"`
+	SeatCategories []string      `yaml:"seat_categories"`
+	Movies         []MovieSeed   `yaml:"movies"`
+	Theaters       []TheaterSeed `yaml:"theaters"`
+	Bookings       []BookingSeed `yaml:"bookings"`
+}
+
+type MovieSeed struct {
+	Title       string  `yaml:"title"`
+	Description string  `yaml:"description"`
+	Duration    int     `yaml:"duration"`
+	Genre       string  `yaml:"genre"`
+	ReleaseDate string  `yaml:"release_date"`
+	Rating      float64 `yaml:"rating"`
+	Language    string  `yaml:"language"`
+}
+
+type TheaterSeed struct {
+	Name        string       `yaml:"name"`
+	Place       string       `yaml:"place"`
+	City        string       `yaml:"city"`
+	District    string       `yaml:"district"`
+	State       string       `yaml:"state"`
+	OwnerID     uint         `yaml:"owner_id"`
+	TheaterType string       `yaml:"theater_type"`
+	Screens     []ScreenSeed `yaml:"screens"`
+}
+
+type ScreenSeed struct {
+	Number       int            `yaml:"number"`
+	SeatCapacity int            `yaml:"seat_capacity"`
+	ScreenType   string         `yaml:"screen_type"`
+	Columns      int            `yaml:"columns"`
+	Rows         []SeatRowSeed  `yaml:"rows"`
+	Showtimes    []ShowtimeSeed `yaml:"showtimes"`
+}
+
+// SeatRowSeed prices a contiguous block of rows, e.g. A to E.
+type SeatRowSeed struct {
+	From     string  `yaml:"from"`
+	To       string  `yaml:"to"`
+	Category string  `yaml:"category"`
+	Price    float32 `yaml:"price"`
+}
+
+// ShowtimeSeed is a show of a movie on the enclosing screen. Date is
+// 2006-01-02 and Time is 15:04, both UTC.
+type ShowtimeSeed struct {
+	Movie    string `yaml:"movie"`
+	Language string `yaml:"language"`
+	Date     string `yaml:"date"`
+	Time     string `yaml:"time"`
+}
+
+type BookingSeed struct {
+	UserID   uint     `yaml:"user_id"`
+	Theater  string   `yaml:"theater"`
+	Screen   int      `yaml:"screen"`
+	Movie    string   `yaml:"movie"`
+	Language string   `yaml:"language"`
+	Date     string   `yaml:"date"`
+	Time     string   `yaml:"time"`
+	Seats    []string `yaml:"seats"`
+	// Status is applied after the booking is created, e.g. "Paid". Empty
+	// leaves the booking pending.
+	Status string `yaml:"status"`
+}
+
+// LoadFile reads a fixture. JSON is valid YAML, so both formats go through
+// the same decoder.
+func LoadFile(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (*Fixture, error) {
+	var fixture Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture: %w", err)
+	}
+	return &fixture, nil
+}
@@ -0,0 +1,336 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/booking"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
+	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"github.com/aparnasukesh/movies-booking-svc/internal/auth"
+	"gorm.io/gorm"
+)
+
+// Loader writes fixtures through the service layer so seeded data passes the
+// same validation as data created over gRPC.
+type Loader struct {
+	movies   movies.Service
+	theatres theatres.Service
+	booking  booking.Service
+	logger   *slog.Logger
+}
+
+func NewLoader(movieSvc movies.Service, theaterSvc theatres.Service, bookingSvc booking.Service, logger *slog.Logger) *Loader {
+	return &Loader{
+		movies:   movieSvc,
+		theatres: theaterSvc,
+		booking:  bookingSvc,
+		logger:   logger,
+	}
+}
+
+// Load applies the fixture. Every record is looked up by its natural key
+// first and only created when missing, so loading the same fixture twice
+// is a no-op.
+func (l *Loader) Load(ctx context.Context, fixture *Fixture) error {
+	admin := auth.WithIdentity(ctx, auth.Identity{Role: auth.RoleSuperAdmin})
+
+	for _, name := range fixture.TheaterTypes {
+		if err := l.theaterType(admin, name); err != nil {
+			return fmt.Errorf("theater type %q: %w", name, err)
+		}
+	}
+	for _, name := range fixture.ScreenTypes {
+		if err := l.screenType(admin, name); err != nil {
+			return fmt.Errorf("screen type %q: %w", name, err)
+		}
+	}
+	for _, name := range fixture.SeatCategories {
+		if err := l.seatCategory(admin, name); err != nil {
+			return fmt.Errorf("seat category %q: %w", name, err)
+		}
+	}
+	for _, movie := range fixture.Movies {
+		if err := l.movie(admin, movie); err != nil {
+			return fmt.Errorf("movie %q: %w", movie.Title, err)
+		}
+	}
+	for _, theater := range fixture.Theaters {
+		if err := l.theater(admin, theater); err != nil {
+			return fmt.Errorf("theater %q: %w", theater.Name, err)
+		}
+	}
+	for i, b := range fixture.Bookings {
+		if err := l.createBooking(ctx, b); err != nil {
+			return fmt.Errorf("booking %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (l *Loader) theaterType(ctx context.Context, name string) error {
+	_, err := l.theatres.GetTheaterTypeByName(ctx, name)
+	if !isNotFound(err) {
+		return err
+	}
+	l.logger.Info("seeding theater type", "name", name)
+	return l.theatres.AddTheaterType(ctx, theatres.TheaterType{TheaterTypeName: name})
+}
+
+func (l *Loader) screenType(ctx context.Context, name string) error {
+	_, err := l.theatres.GetScreenTypeByName(ctx, name)
+	if !isNotFound(err) {
+		return err
+	}
+	l.logger.Info("seeding screen type", "name", name)
+	return l.theatres.AddScreenType(ctx, theatres.ScreenType{ScreenTypeName: name})
+}
+
+func (l *Loader) seatCategory(ctx context.Context, name string) error {
+	_, err := l.theatres.GetSeatCategoryByName(ctx, name)
+	if !isNotFound(err) {
+		return err
+	}
+	l.logger.Info("seeding seat category", "name", name)
+	return l.theatres.AddSeatCategory(ctx, theatres.SeatCategory{SeatCategoryName: name})
+}
+
+func (l *Loader) movie(ctx context.Context, seed MovieSeed) error {
+	_, err := l.movies.GetMovieByNameAndLanguage(ctx, seed.Title, seed.Language)
+	if !isNotFound(err) {
+		return err
+	}
+	releaseDate, err := time.Parse(time.DateOnly, seed.ReleaseDate)
+	if err != nil {
+		return fmt.Errorf("invalid release_date: %w", err)
+	}
+	l.logger.Info("seeding movie", "title", seed.Title, "language", seed.Language)
+	_, err = l.movies.RegisterMovie(ctx, movies.Movie{
+		Title:       seed.Title,
+		Description: seed.Description,
+		Duration:    seed.Duration,
+		Genre:       seed.Genre,
+		ReleaseDate: releaseDate,
+		Rating:      seed.Rating,
+		Language:    seed.Language,
+	})
+	return err
+}
+
+func (l *Loader) theater(ctx context.Context, seed TheaterSeed) error {
+	theater, err := l.findTheater(ctx, seed.Name)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	if theater == nil {
+		theaterType, err := l.theatres.GetTheaterTypeByName(ctx, seed.TheaterType)
+		if err != nil {
+			return fmt.Errorf("theater type %q: %w", seed.TheaterType, err)
+		}
+		l.logger.Info("seeding theater", "name", seed.Name, "city", seed.City)
+		if err := l.theatres.AddTheater(ctx, theatres.Theater{
+			Name:            seed.Name,
+			Place:           seed.Place,
+			City:            seed.City,
+			District:        seed.District,
+			State:           seed.State,
+			OwnerID:         seed.OwnerID,
+			NumberOfScreens: len(seed.Screens),
+			TheaterTypeID:   int(theaterType.ID),
+		}); err != nil {
+			return err
+		}
+		if theater, err = l.findTheater(ctx, seed.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, screen := range seed.Screens {
+		if err := l.screen(ctx, theater, screen); err != nil {
+			return fmt.Errorf("screen %d: %w", screen.Number, err)
+		}
+	}
+	return nil
+}
+
+func (l *Loader) screen(ctx context.Context, theater *theatres.Theater, seed ScreenSeed) error {
+	screen, err := l.theatres.GetTheaterScreenByNumber(ctx, int(theater.ID), seed.Number)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	if screen == nil {
+		screenType, err := l.theatres.GetScreenTypeByName(ctx, seed.ScreenType)
+		if err != nil {
+			return fmt.Errorf("screen type %q: %w", seed.ScreenType, err)
+		}
+		l.logger.Info("seeding theater screen", "theater", theater.Name, "screen", seed.Number)
+		if err := l.theatres.AddTheaterScreen(ctx, theatres.TheaterScreen{
+			TheaterID:    int(theater.ID),
+			ScreenNumber: seed.Number,
+			SeatCapacity: seed.SeatCapacity,
+			ScreenTypeID: int(screenType.ID),
+		}); err != nil {
+			return err
+		}
+		if screen, err = l.theatres.GetTheaterScreenByNumber(ctx, int(theater.ID), seed.Number); err != nil {
+			return err
+		}
+	}
+
+	// CreateSeats skips seats that already exist.
+	if len(seed.Rows) > 0 {
+		req := theatres.CreateSeatsRequest{ScreenId: int(screen.ID), TotalColumns: seed.Columns}
+		for _, row := range seed.Rows {
+			category, err := l.theatres.GetSeatCategoryByName(ctx, row.Category)
+			if err != nil {
+				return fmt.Errorf("seat category %q: %w", row.Category, err)
+			}
+			req.SeatRequest = append(req.SeatRequest, theatres.RowSeatCategoryPrice{
+				RowStart:          row.From,
+				RowEnd:            row.To,
+				SeatCategoryId:    int(category.ID),
+				SeatCategoryPrice: row.Price,
+			})
+		}
+		if err := l.theatres.CreateSeats(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	for _, show := range seed.Showtimes {
+		if err := l.showtime(ctx, int(screen.ID), show); err != nil {
+			return fmt.Errorf("showtime %s %s: %w", show.Date, show.Time, err)
+		}
+	}
+	return nil
+}
+
+func (l *Loader) showtime(ctx context.Context, screenID int, seed ShowtimeSeed) error {
+	movie, err := l.findMovie(ctx, seed.Movie, seed.Language)
+	if err != nil {
+		return err
+	}
+	showDate, showTime, err := parseShowtime(seed.Date, seed.Time)
+	if err != nil {
+		return err
+	}
+	_, err = l.theatres.GetShowtimeByDetails(ctx, int(movie.ID), screenID, showDate, showTime)
+	if !isNotFound(err) {
+		return err
+	}
+	l.logger.Info("seeding showtime", "movie", seed.Movie, "screen_id", screenID, "show_time", showTime)
+	_, err = l.theatres.AddShowtime(ctx, theatres.Showtime{
+		MovieID:  int(movie.ID),
+		ScreenID: screenID,
+		ShowDate: showDate,
+		ShowTime: showTime,
+	})
+	return err
+}
+
+// createBooking books as the fixture's user. Seats that are already booked
+// for the show are treated as seeded by an earlier run.
+func (l *Loader) createBooking(ctx context.Context, seed BookingSeed) error {
+	theater, err := l.findTheater(ctx, seed.Theater)
+	if err != nil {
+		return fmt.Errorf("theater %q: %w", seed.Theater, err)
+	}
+	screen, err := l.theatres.GetTheaterScreenByNumber(ctx, int(theater.ID), seed.Screen)
+	if err != nil {
+		return fmt.Errorf("screen %d: %w", seed.Screen, err)
+	}
+	movie, err := l.findMovie(ctx, seed.Movie, seed.Language)
+	if err != nil {
+		return err
+	}
+	showDate, showTime, err := parseShowtime(seed.Date, seed.Time)
+	if err != nil {
+		return err
+	}
+	showtime, err := l.theatres.GetShowtimeByDetails(ctx, int(movie.ID), int(screen.ID), showDate, showTime)
+	if err != nil {
+		return fmt.Errorf("showtime: %w", err)
+	}
+	seatIDs := make([]int, 0, len(seed.Seats))
+	for _, number := range seed.Seats {
+		seat, err := l.theatres.GetSeatBySeatNumberAndScreenId(ctx, int(screen.ID), number)
+		if err != nil {
+			return fmt.Errorf("seat %s: %w", number, err)
+		}
+		seatIDs = append(seatIDs, int(seat.ID))
+	}
+
+	user := auth.WithIdentity(ctx, auth.Identity{UserID: seed.UserID, Role: auth.RoleUser})
+	created, _, err := l.booking.CreateBooking(user, booking.CreateBookingRequest{
+		UserID:     int(seed.UserID),
+		ShowtimeID: int(showtime.ID),
+		ScreenID:   screen.ID,
+		SeatIDs:    seatIDs,
+	})
+	if apperrors.Is(err, apperrors.KindConflict) {
+		l.logger.Info("skipping booking, seats already booked", "user_id", seed.UserID, "showtime_id", showtime.ID, "seats", strings.Join(seed.Seats, ","))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	l.logger.Info("seeded booking", "booking_id", created.BookingID, "user_id", seed.UserID)
+
+	if seed.Status != "" {
+		payment := auth.WithIdentity(ctx, auth.Identity{Role: auth.RoleService})
+		return l.booking.UpdateBookingStatusByBookingID(payment, int(created.BookingID), seed.Status)
+	}
+	return nil
+}
+
+func (l *Loader) findTheater(ctx context.Context, name string) (*theatres.Theater, error) {
+	theaters, err := l.theatres.GetTheaterByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range theaters {
+		if strings.EqualFold(theaters[i].Name, name) {
+			return &theaters[i], nil
+		}
+	}
+	return nil, apperrors.NotFound("no theater named %s", name)
+}
+
+func (l *Loader) findMovie(ctx context.Context, title, language string) (*movies.Movie, error) {
+	var (
+		movie *movies.Movie
+		err   error
+	)
+	if language == "" {
+		movie, err = l.movies.GetMovieByName(ctx, title)
+	} else {
+		movie, err = l.movies.GetMovieByNameAndLanguage(ctx, title, language)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("movie %q: %w", title, err)
+	}
+	return movie, nil
+}
+
+func parseShowtime(date, clock string) (time.Time, time.Time, error) {
+	showDate, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+	showTime, err := time.Parse(time.DateOnly+" 15:04", date+" "+clock)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid time %q: %w", clock, err)
+	}
+	return showDate, showTime, nil
+}
+
+// isNotFound reports whether a lookup found nothing. Services return either
+// the gorm sentinel or a typed not found error.
+func isNotFound(err error) bool {
+	return errors.Is(err, gorm.ErrRecordNotFound) || apperrors.Is(err, apperrors.KindNotFound)
+}
@@ -0,0 +1,18 @@
+package eticket
+
+import (
+	"fmt"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const qrCodeSize = 512
+
+// QRCodePNG renders the token as a PNG encoded QR code.
+func QRCodePNG(token string) ([]byte, error) {
+	png, err := qrcode.Encode(token, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render ticket qr code: %w", err)
+	}
+	return png, nil
+}
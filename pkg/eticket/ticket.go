@@ -0,0 +1,111 @@
+package eticket
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Version is the prefix of every ticket token. It is part of the signed
+// content so a scanner never accepts a payload meant for another format.
+const Version = "BYS1"
+
+var (
+	ErrMalformedToken   = errors.New("malformed ticket token")
+	ErrInvalidSignature = errors.New("invalid ticket signature")
+	ErrNotYetValid      = errors.New("ticket is not valid yet")
+	ErrExpired          = errors.New("ticket has expired")
+)
+
+// Payload is the content encoded in the QR code of an e-ticket. Field names
+// are kept short so the QR code stays small enough to scan reliably.
+type Payload struct {
+	BookingID   uint     `json:"b"`
+	ShowtimeID  uint     `json:"s"`
+	SeatNumbers []string `json:"n"`
+	ValidFrom   int64    `json:"f"`
+	ValidUntil  int64    `json:"u"`
+}
+
+// Signer signs ticket payloads with an Ed25519 private key.
+type Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewSigner builds a Signer from a base64 encoded Ed25519 seed (32 bytes) or
+// private key (64 bytes).
+func NewSigner(encodedKey string) (*Signer, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ticket signing key: %w", err)
+	}
+	switch len(key) {
+	case ed25519.SeedSize:
+		return &Signer{privateKey: ed25519.NewKeyFromSeed(key)}, nil
+	case ed25519.PrivateKeySize:
+		return &Signer{privateKey: ed25519.PrivateKey(key)}, nil
+	default:
+		return nil, fmt.Errorf("invalid ticket signing key length %d", len(key))
+	}
+}
+
+// PublicKey returns the key scanners use to verify tickets offline.
+func (s *Signer) PublicKey() ed25519.PublicKey {
+	return s.privateKey.Public().(ed25519.PublicKey)
+}
+
+// Sign encodes the payload as a token of the form
+// "BYS1.<base64url payload>.<base64url signature>".
+func (s *Signer) Sign(payload Payload) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ticket payload: %w", err)
+	}
+	signed := Version + "." + base64.RawURLEncoding.EncodeToString(data)
+	signature := ed25519.Sign(s.privateKey, []byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Parse checks the token signature against the public key and returns the
+// payload. It does not check the validity window, see Verify.
+func Parse(publicKey ed25519.PublicKey, token string) (*Payload, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != Version {
+		return nil, ErrMalformedToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	if !ed25519.Verify(publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrInvalidSignature
+	}
+	data, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	payload := &Payload{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, ErrMalformedToken
+	}
+	return payload, nil
+}
+
+// Verify parses the token and checks that now falls inside its validity window.
+func Verify(publicKey ed25519.PublicKey, token string, now time.Time) (*Payload, error) {
+	payload, err := Parse(publicKey, token)
+	if err != nil {
+		return nil, err
+	}
+	if now.Unix() < payload.ValidFrom {
+		return nil, ErrNotYetValid
+	}
+	if now.Unix() > payload.ValidUntil {
+		return nil, ErrExpired
+	}
+	return payload, nil
+}
@@ -0,0 +1,180 @@
+package eticket
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestSigner(t *testing.T) *Signer {
+	t.Helper()
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewSigner(base64.StdEncoding.EncodeToString(seed))
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	return signer
+}
+
+func testPayload(now time.Time) Payload {
+	return Payload{
+		BookingID:   42,
+		ShowtimeID:  7,
+		SeatNumbers: []string{"A1", "A2"},
+		ValidFrom:   now.Add(-time.Hour).Unix(),
+		ValidUntil:  now.Add(time.Hour).Unix(),
+	}
+}
+
+func signPayload(t *testing.T, signer *Signer, payload Payload) string {
+	t.Helper()
+	token, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return token
+}
+
+func TestNewSigner(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+	private := ed25519.NewKeyFromSeed(seed)
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "seed", key: base64.StdEncoding.EncodeToString(seed)},
+		{name: "seed with whitespace", key: " " + base64.StdEncoding.EncodeToString(seed) + "\n"},
+		{name: "private key", key: base64.StdEncoding.EncodeToString(private)},
+		{name: "empty", key: "", wantErr: true},
+		{name: "not base64", key: "not a key!", wantErr: true},
+		{name: "wrong length", key: base64.StdEncoding.EncodeToString(seed[:16]), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewSigner(tt.key)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewSigner succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSigner: %v", err)
+			}
+			if !signer.PublicKey().Equal(private.Public()) {
+				t.Error("public key does not match the key pair")
+			}
+		})
+	}
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	now := time.Now()
+	signer := newTestSigner(t)
+	payload := testPayload(now)
+	token := signPayload(t, signer, payload)
+
+	if !strings.HasPrefix(token, Version+".") || strings.Count(token, ".") != 2 {
+		t.Fatalf("token %q is not of the form %s.<payload>.<signature>", token, Version)
+	}
+	got, err := Verify(signer.PublicKey(), token, now)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if !reflect.DeepEqual(*got, payload) {
+		t.Errorf("payload = %+v, want %+v", *got, payload)
+	}
+}
+
+func TestParseRejectsTamperedPayload(t *testing.T) {
+	now := time.Now()
+	signer := newTestSigner(t)
+	token := signPayload(t, signer, testPayload(now))
+
+	forged := testPayload(now)
+	forged.SeatNumbers = []string{"A1", "A2", "A3"}
+	forgedToken := signPayload(t, signer, forged)
+
+	// Keep the original signature but swap in the payload of another ticket.
+	parts := strings.Split(token, ".")
+	parts[1] = strings.Split(forgedToken, ".")[1]
+	if _, err := Parse(signer.PublicKey(), strings.Join(parts, ".")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Parse(tampered) error = %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestParseRejectsWrongKey(t *testing.T) {
+	now := time.Now()
+	token := signPayload(t, newTestSigner(t), testPayload(now))
+	if _, err := Parse(newTestSigner(t).PublicKey(), token); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Parse with another key error = %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestVerifyValidityWindow(t *testing.T) {
+	now := time.Now()
+	signer := newTestSigner(t)
+	payload := testPayload(now)
+	token := signPayload(t, signer, payload)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want error
+	}{
+		{name: "window opens", at: time.Unix(payload.ValidFrom, 0)},
+		{name: "window closes", at: time.Unix(payload.ValidUntil, 0)},
+		{name: "before window", at: time.Unix(payload.ValidFrom-1, 0), want: ErrNotYetValid},
+		{name: "after window", at: time.Unix(payload.ValidUntil+1, 0), want: ErrExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(signer.PublicKey(), token, tt.at)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Verify error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsMalformedToken(t *testing.T) {
+	signer := newTestSigner(t)
+	token := signPayload(t, signer, testPayload(time.Now()))
+	parts := strings.Split(token, ".")
+
+	// A validly signed payload that is not JSON.
+	signed := Version + "." + base64.RawURLEncoding.EncodeToString([]byte("not json"))
+	notJSON := signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(signer.privateKey, []byte(signed)))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "two parts", token: parts[0] + "." + parts[1]},
+		{name: "four parts", token: token + ".x"},
+		{name: "wrong version", token: "BYS0." + parts[1] + "." + parts[2]},
+		{name: "signature not base64", token: parts[0] + "." + parts[1] + ".!!"},
+		{name: "payload not json", token: notJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(signer.PublicKey(), tt.token); !errors.Is(err, ErrMalformedToken) {
+				t.Errorf("Parse error = %v, want %v", err, ErrMalformedToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package grpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+)
+
+// Factory dials the services this one calls. Every connection shares the
+// transport settings from config; targets and call timeouts are per service.
+//
+// Targets are gRPC target strings, e.g. "dns:///payment-svc.payments:5054",
+// "localhost:5054" or "static:///10.0.0.7:5054,10.0.0.8:5054". A service
+// without a target falls back to its in-cluster DNS name and the legacy
+// port setting.
+type Factory struct {
+	cfg   config.Config
+	creds credentials.TransportCredentials
+}
+
+func NewFactory(cfg config.Config) (*Factory, error) {
+	creds := insecure.NewCredentials()
+	if cfg.GrpcClientTLS {
+		tlsConfig, err := clientTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	return &Factory{cfg: cfg, creds: creds}, nil
+}
+
+// Dial connects to service. The connection is lazy, so an unreachable
+// service only shows up in health checks and calls. Only the methods named
+// in retryable, as full method names, are retried; they must be safe to
+// run twice.
+func (f *Factory) Dial(service, target, legacyPort string, timeout time.Duration, retryable ...string) (*grpc.ClientConn, error) {
+	if target == "" {
+		if legacyPort == "" {
+			return nil, fmt.Errorf("no target or port configured for %s", service)
+		}
+		target = "dns:///" + service + ".default.svc.cluster.local:" + legacyPort
+	}
+	serviceConfig, err := f.serviceConfig(timeout, retryable)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(f.creds),
+		grpc.WithResolvers(staticResolverBuilder{}),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	if f.cfg.GrpcClientKeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                f.cfg.GrpcClientKeepaliveTime,
+			Timeout:             f.cfg.GrpcClientKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		slog.Error("failed to create grpc client", "service", service, "target", target, "error", err)
+		return nil, err
+	}
+	return conn, nil
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// methodName matches every method of the service when both fields are
+// empty.
+type methodName struct {
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// serviceConfig balances across all resolved addresses and applies the call
+// timeout to every method. Only the retryable methods get a retry policy:
+// UNAVAILABLE doesn't prove the service never saw the request, e.g. when the
+// connection drops mid call, so anything that changes state is left to the
+// caller.
+func (f *Factory) serviceConfig(timeout time.Duration, retryable []string) (string, error) {
+	all := methodConfig{Name: []methodName{{}}}
+	if timeout > 0 {
+		all.Timeout = durationString(timeout)
+	}
+	methods := []methodConfig{all}
+	if f.cfg.GrpcClientMaxAttempts > 1 && len(retryable) > 0 {
+		retried := methodConfig{
+			Timeout: all.Timeout,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          f.cfg.GrpcClientMaxAttempts,
+				InitialBackoff:       durationString(f.cfg.GrpcClientInitialBackoff),
+				MaxBackoff:           durationString(f.cfg.GrpcClientMaxBackoff),
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}
+		for _, fullMethod := range retryable {
+			service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+			if !ok || service == "" || method == "" {
+				return "", fmt.Errorf("invalid retryable method %q", fullMethod)
+			}
+			retried.Name = append(retried.Name, methodName{Service: service, Method: method})
+		}
+		methods = append(methods, retried)
+	}
+	serviceConfig, err := json.Marshal(map[string]interface{}{
+		"loadBalancingConfig": []map[string]struct{}{{"round_robin": {}}},
+		"methodConfig":        methods,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(serviceConfig), nil
+}
+
+func durationString(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// clientTLSConfig verifies servers against GrpcClientCAFile, or the system
+// roots when it is empty, and presents a client certificate for mTLS when
+// one is configured.
+func clientTLSConfig(cfg config.Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.GrpcClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.GrpcClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.GrpcClientCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.GrpcClientCertFile != "" || cfg.GrpcClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.GrpcClientCertFile, cfg.GrpcClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// staticResolverBuilder resolves "static:///host1:port,host2:port" to a
+// fixed list of addresses.
+type staticResolverBuilder struct{}
+
+func (staticResolverBuilder) Scheme() string { return "static" }
+
+func (staticResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	var addresses []resolver.Address
+	for _, addr := range strings.Split(target.Endpoint(), ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addresses = append(addresses, resolver.Address{Addr: addr})
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("static target %q has no addresses", target.URL.String())
+	}
+	if err := cc.UpdateState(resolver.State{Addresses: addresses}); err != nil {
+		return nil, err
+	}
+	return staticResolver{}, nil
+}
+
+type staticResolver struct{}
+
+func (staticResolver) ResolveNow(resolver.ResolveNowOptions) {}
+func (staticResolver) Close()                                {}
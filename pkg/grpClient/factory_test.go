@@ -0,0 +1,78 @@
+package grpclient
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/movies-booking-svc/config"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestServiceConfigRetriesOnlyNamedMethods(t *testing.T) {
+	f := &Factory{cfg: config.Config{
+		GrpcClientMaxAttempts:    3,
+		GrpcClientInitialBackoff: 100 * time.Millisecond,
+		GrpcClientMaxBackoff:     time.Second,
+	}}
+
+	tests := []struct {
+		name      string
+		retryable []string
+		want      []methodConfig
+	}{
+		{
+			name: "no retryable methods",
+			want: []methodConfig{{Name: []methodName{{}}, Timeout: "2s"}},
+		},
+		{
+			name:      "named method",
+			retryable: []string{payment.PaymentService_GetTransactionStatus_FullMethodName},
+			want: []methodConfig{
+				{Name: []methodName{{}}, Timeout: "2s"},
+				{
+					Name:    []methodName{{Service: "payment.PaymentService", Method: "GetTransactionStatus"}},
+					Timeout: "2s",
+					RetryPolicy: &retryPolicy{
+						MaxAttempts:          3,
+						InitialBackoff:       "0.1s",
+						MaxBackoff:           "1s",
+						BackoffMultiplier:    2,
+						RetryableStatusCodes: []string{"UNAVAILABLE"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := f.serviceConfig(2*time.Second, tt.retryable)
+			if err != nil {
+				t.Fatalf("serviceConfig: %v", err)
+			}
+			var got struct {
+				MethodConfig []methodConfig `json:"methodConfig"`
+			}
+			if err := json.Unmarshal([]byte(raw), &got); err != nil {
+				t.Fatalf("unmarshal %s: %v", raw, err)
+			}
+			want, _ := json.Marshal(tt.want)
+			have, _ := json.Marshal(got.MethodConfig)
+			if string(have) != string(want) {
+				t.Fatalf("method config\n got %s\nwant %s", have, want)
+			}
+		})
+	}
+
+	f.creds = insecure.NewCredentials()
+	conn, err := f.Dial("payment-svc", "localhost:5054", "", time.Second, payment.PaymentService_GetTransactionStatus_FullMethodName)
+	if err != nil {
+		t.Fatalf("grpc rejected the service config: %v", err)
+	}
+	conn.Close()
+
+	if _, err := f.serviceConfig(0, []string{"GetTransactionStatus"}); err == nil {
+		t.Fatal("expected an error for a method without a service")
+	}
+}
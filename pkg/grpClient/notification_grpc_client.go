@@ -0,0 +1,17 @@
+package grpclient
+
+import (
+	pb "github.com/aparnasukesh/inter-communication/notification"
+
+	"google.golang.org/grpc"
+)
+
+// NewNotificationServiceClient dials the notification service without
+// transport retries: a retried SendEmail can reach the user twice.
+func (f *Factory) NewNotificationServiceClient() (pb.EmailServiceClient, *grpc.ClientConn, error) {
+	conn, err := f.Dial("notification-svc", f.cfg.NotificationTarget, f.cfg.GrpcNotificationPort, f.cfg.NotificationTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pb.NewEmailServiceClient(conn), conn, nil
+}
@@ -1,24 +1,38 @@
 package grpclient
 
 import (
-	"log"
+	"context"
+	"fmt"
 
 	pb "github.com/aparnasukesh/inter-communication/payment"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
-func NewBookingPaymentServiceClient(port string) (pb.PaymentServiceClient, error) {
-	// conn, err := grpc.Dial("localhost:"+port, grpc.WithInsecure())
-	// if err != nil {
-	// 	return nil, err
-	// }
-	address := "payment-svc.default.svc.cluster.local:" + port
-	serviceConfig := `{"loadBalancingPolicy": "round_robin"}`
-	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithDefaultServiceConfig(serviceConfig))
+// NewBookingPaymentServiceClient dials the payment service without
+// transport retries. GetTransactionStatus is retried by the resilient
+// client instead, and the other calls move money.
+func (f *Factory) NewBookingPaymentServiceClient() (pb.PaymentServiceClient, *grpc.ClientConn, error) {
+	conn, err := f.Dial("payment-svc", f.cfg.PaymentTarget, f.cfg.GrpcPaymentPort, f.cfg.PaymentTimeout)
 	if err != nil {
-		log.Printf("Failed to connect to gRPC service: %v", err)
-		return nil, err
+		return nil, nil, err
+	}
+	return pb.NewPaymentServiceClient(conn), conn, nil
+}
+
+// ConnReady reports whether conn can reach its target. An idle connection is
+// asked to connect and counts as ready until it actually fails.
+func ConnReady(conn *grpc.ClientConn) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		switch state := conn.GetState(); state {
+		case connectivity.Ready, connectivity.Connecting:
+			return nil
+		case connectivity.Idle:
+			conn.Connect()
+			return nil
+		default:
+			return fmt.Errorf("connection to %s is %s", conn.Target(), state)
+		}
 	}
-	return pb.NewPaymentServiceClient(conn), nil
 }
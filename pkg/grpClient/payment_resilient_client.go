@@ -0,0 +1,102 @@
+package grpclient
+
+import (
+	"context"
+
+	pb "github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/resilience"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resilientPaymentClient sends every call through a circuit breaker so a
+// payment outage fails fast instead of holding up each caller until its
+// deadline. Only GetTransactionStatus is retried; the other calls change
+// state on the payment side and are left to the caller.
+type resilientPaymentClient struct {
+	client  pb.PaymentServiceClient
+	breaker *resilience.Breaker
+	backoff resilience.Backoff
+}
+
+func NewResilientPaymentClient(client pb.PaymentServiceClient, breaker *resilience.Breaker, backoff resilience.Backoff) pb.PaymentServiceClient {
+	return &resilientPaymentClient{
+		client:  client,
+		breaker: breaker,
+		backoff: backoff,
+	}
+}
+
+func (c *resilientPaymentClient) ProcessPayment(ctx context.Context, in *pb.ProcessPaymentRequest, opts ...grpc.CallOption) (*pb.ProcessPaymentResponse, error) {
+	var resp *pb.ProcessPaymentResponse
+	err := c.call(ctx, func() (err error) {
+		resp, err = c.client.ProcessPayment(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *resilientPaymentClient) GetTransactionStatus(ctx context.Context, in *pb.GetTransactionStatusRequest, opts ...grpc.CallOption) (*pb.GetTransactionStatusResponse, error) {
+	var resp *pb.GetTransactionStatusResponse
+	err := c.backoff.Retry(ctx, isTransient, func(ctx context.Context) error {
+		return c.call(ctx, func() (err error) {
+			resp, err = c.client.GetTransactionStatus(ctx, in, opts...)
+			return err
+		})
+	})
+	return resp, err
+}
+
+func (c *resilientPaymentClient) PaymentSuccess(ctx context.Context, in *pb.PaymentSuccessRequest, opts ...grpc.CallOption) (*pb.PaymentSuccessResponse, error) {
+	var resp *pb.PaymentSuccessResponse
+	err := c.call(ctx, func() (err error) {
+		resp, err = c.client.PaymentSuccess(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *resilientPaymentClient) PaymentFailure(ctx context.Context, in *pb.PaymentFailureRequest, opts ...grpc.CallOption) (*pb.PaymentFailureResponse, error) {
+	var resp *pb.PaymentFailureResponse
+	err := c.call(ctx, func() (err error) {
+		resp, err = c.client.PaymentFailure(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// call runs fn if the breaker allows it. A rejected call returns
+// breakerOpenError. A call cut short by the caller's own context says
+// nothing about the payment service and is recorded neither way.
+func (c *resilientPaymentClient) call(ctx context.Context, fn func() error) error {
+	err := c.breaker.Call(ctx, isTransient, func(context.Context) error { return fn() })
+	if err == resilience.ErrOpen {
+		return breakerOpenError{}
+	}
+	return err
+}
+
+// breakerOpenError reads as Unavailable to gRPC, like an unreachable service,
+// and unwraps to resilience.ErrOpen so callers can tell that the request was
+// never sent.
+type breakerOpenError struct{}
+
+func (breakerOpenError) Error() string { return "payment service: " + resilience.ErrOpen.Error() }
+
+func (breakerOpenError) Unwrap() error { return resilience.ErrOpen }
+
+func (e breakerOpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+// isTransient reports whether err says the payment service is unreachable or
+// overloaded rather than that it rejected the request.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
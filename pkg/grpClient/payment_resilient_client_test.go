@@ -0,0 +1,122 @@
+package grpclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/aparnasukesh/inter-communication/payment"
+	"github.com/aparnasukesh/movies-booking-svc/pkg/resilience"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubPaymentClient answers every call with the next error in errs, or
+// success once they run out.
+type stubPaymentClient struct {
+	pb.PaymentServiceClient
+	errs  []error
+	calls int
+}
+
+func (c *stubPaymentClient) next(ctx context.Context) error {
+	c.calls++
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	if errors.Is(err, context.DeadlineExceeded) {
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return err
+}
+
+func (c *stubPaymentClient) ProcessPayment(ctx context.Context, in *pb.ProcessPaymentRequest, opts ...grpc.CallOption) (*pb.ProcessPaymentResponse, error) {
+	if err := c.next(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.ProcessPaymentResponse{}, nil
+}
+
+func (c *stubPaymentClient) GetTransactionStatus(ctx context.Context, in *pb.GetTransactionStatusRequest, opts ...grpc.CallOption) (*pb.GetTransactionStatusResponse, error) {
+	if err := c.next(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.GetTransactionStatusResponse{TransactionId: in.TransactionId, Status: "Pending"}, nil
+}
+
+func newTestClient(stub *stubPaymentClient, threshold int) (pb.PaymentServiceClient, *resilience.Breaker) {
+	breaker := resilience.NewBreaker(threshold, time.Hour, nil)
+	return NewResilientPaymentClient(stub, breaker, resilience.Backoff{Attempts: 3, Initial: time.Millisecond}), breaker
+}
+
+func TestResilientClientOpensOnServiceFailures(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	stub := &stubPaymentClient{errs: []error{unavailable, unavailable}}
+	client, breaker := newTestClient(stub, 2)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.ProcessPayment(context.Background(), &pb.ProcessPaymentRequest{}); status.Code(err) != codes.Unavailable {
+			t.Fatalf("ProcessPayment = %v, want Unavailable", err)
+		}
+	}
+	if breaker.State() != resilience.StateOpen {
+		t.Fatalf("breaker is %s, want open", breaker.State())
+	}
+
+	_, err := client.ProcessPayment(context.Background(), &pb.ProcessPaymentRequest{})
+	if !errors.Is(err, resilience.ErrOpen) || status.Code(err) != codes.Unavailable {
+		t.Fatalf("ProcessPayment with the breaker open = %v, want ErrOpen as Unavailable", err)
+	}
+	if stub.calls != 2 {
+		t.Fatalf("payment service got %d calls, want 2", stub.calls)
+	}
+}
+
+func TestResilientClientIgnoresCallerDeadline(t *testing.T) {
+	stub := &stubPaymentClient{errs: []error{context.DeadlineExceeded}}
+	client, breaker := newTestClient(stub, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.ProcessPayment(ctx, &pb.ProcessPaymentRequest{}); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("ProcessPayment = %v, want DeadlineExceeded", err)
+	}
+	if breaker.State() != resilience.StateClosed {
+		t.Fatalf("breaker is %s after the caller's deadline, want closed", breaker.State())
+	}
+
+	// A deadline the service itself reports still counts.
+	stub.errs = []error{status.Error(codes.DeadlineExceeded, "upstream timeout")}
+	client.ProcessPayment(context.Background(), &pb.ProcessPaymentRequest{})
+	if breaker.State() != resilience.StateOpen {
+		t.Fatalf("breaker is %s, want open", breaker.State())
+	}
+}
+
+func TestResilientClientRetriesOnlyStatusLookups(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	stub := &stubPaymentClient{errs: []error{unavailable}}
+	client, _ := newTestClient(stub, 10)
+	if _, err := client.ProcessPayment(context.Background(), &pb.ProcessPaymentRequest{}); err == nil || stub.calls != 1 {
+		t.Fatalf("ProcessPayment = %v after %d calls, want the error after 1 call", err, stub.calls)
+	}
+
+	stub = &stubPaymentClient{errs: []error{unavailable, unavailable}}
+	client, _ = newTestClient(stub, 10)
+	resp, err := client.GetTransactionStatus(context.Background(), &pb.GetTransactionStatusRequest{TransactionId: 7})
+	if err != nil || resp.TransactionId != 7 || stub.calls != 3 {
+		t.Fatalf("GetTransactionStatus = %v, %v after %d calls, want success after 3 calls", resp, err, stub.calls)
+	}
+
+	stub = &stubPaymentClient{errs: []error{status.Error(codes.NotFound, "no transaction")}}
+	client, _ = newTestClient(stub, 10)
+	if _, err := client.GetTransactionStatus(context.Background(), &pb.GetTransactionStatusRequest{}); status.Code(err) != codes.NotFound || stub.calls != 1 {
+		t.Fatalf("GetTransactionStatus = %v after %d calls, want NotFound after 1 call", err, stub.calls)
+	}
+}
@@ -0,0 +1,137 @@
+package grpclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/aparnasukesh/inter-communication/user_admin"
+
+	"google.golang.org/grpc"
+)
+
+func (f *Factory) NewUserAdminServiceClient() (pb.SuperAdminServiceClient, *grpc.ClientConn, error) {
+	conn, err := f.Dial("user-admin-svc", f.cfg.UserAdminTarget, f.cfg.GrpcUserAdminServicePort, f.cfg.UserAdminTimeout,
+		pb.SuperAdminService_GetUserByID_FullMethodName,
+		pb.SuperAdminService_GetAdminByID_FullMethodName,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pb.NewSuperAdminServiceClient(conn), conn, nil
+}
+
+// UserDirectory looks up users and theater admins in the user-admin service.
+// Errors are the gRPC statuses returned by the service, so callers can tell
+// an unknown id (codes.NotFound) from the service being unreachable.
+type UserDirectory interface {
+	GetUser(ctx context.Context, userID int) (*pb.User, error)
+	GetAdmin(ctx context.Context, adminID int) (*pb.Admin, error)
+}
+
+// Past this many entries expired ones are dropped, and if that isn't
+// enough the cache starts over.
+const maxDirectoryEntries = 10000
+
+type cacheEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+type userDirectory struct {
+	client pb.SuperAdminServiceClient
+	ttl    time.Duration
+
+	mu     sync.Mutex
+	users  map[int]cacheEntry[*pb.User]
+	admins map[int]cacheEntry[*pb.Admin]
+}
+
+// NewUserDirectory wraps client with an in-memory cache of verified
+// records. Lookups that fail and records that aren't verified yet are not
+// cached, so an approval shows up on the next call. A zero ttl disables the
+// cache.
+func NewUserDirectory(client pb.SuperAdminServiceClient, ttl time.Duration) UserDirectory {
+	return &userDirectory{
+		client: client,
+		ttl:    ttl,
+		users:  make(map[int]cacheEntry[*pb.User]),
+		admins: make(map[int]cacheEntry[*pb.Admin]),
+	}
+}
+
+func (d *userDirectory) GetUser(ctx context.Context, userID int) (*pb.User, error) {
+	if user, ok := cached(d, d.users, userID); ok {
+		return user, nil
+	}
+	resp, err := d.client.GetUserByID(ctx, &pb.GetUserByIdRequest{UserId: int32(userID)})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetUser().GetIsVerified() {
+		store(d, d.users, userID, resp.GetUser())
+	}
+	return resp.GetUser(), nil
+}
+
+func (d *userDirectory) GetAdmin(ctx context.Context, adminID int) (*pb.Admin, error) {
+	if admin, ok := cached(d, d.admins, adminID); ok {
+		return admin, nil
+	}
+	resp, err := d.client.GetAdminByID(ctx, &pb.GetAdminByIdRequest{AdminId: int32(adminID)})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetAdmin().GetIsVerified() {
+		store(d, d.admins, adminID, resp.GetAdmin())
+	}
+	return resp.GetAdmin(), nil
+}
+
+func cached[T any](d *userDirectory, entries map[int]cacheEntry[T], id int) (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	entry, ok := entries[id]
+	if !ok || time.Now().After(entry.expires) {
+		var zero T
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func store[T any](d *userDirectory, entries map[int]cacheEntry[T], id int, value T) {
+	if d.ttl <= 0 {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	if len(entries) >= maxDirectoryEntries {
+		for key, entry := range entries {
+			if now.After(entry.expires) {
+				delete(entries, key)
+			}
+		}
+		if len(entries) >= maxDirectoryEntries {
+			clear(entries)
+		}
+	}
+	entries[id] = cacheEntry[T]{value: value, expires: now.Add(d.ttl)}
+}
+
+type allowAllDirectory struct{}
+
+// NewAllowAllDirectory returns a directory in which every id is a verified
+// user and theater admin. Only the seed command's --allow-all-users flag uses
+// it; the server always asks the user-admin service.
+func NewAllowAllDirectory() UserDirectory {
+	return allowAllDirectory{}
+}
+
+func (allowAllDirectory) GetUser(_ context.Context, userID int) (*pb.User, error) {
+	return &pb.User{Id: int32(userID), IsVerified: true}, nil
+}
+
+func (allowAllDirectory) GetAdmin(_ context.Context, adminID int) (*pb.Admin, error) {
+	return &pb.Admin{Id: int32(adminID), IsVerified: true}, nil
+}
@@ -0,0 +1,89 @@
+package grpclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/aparnasukesh/inter-communication/user_admin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubUserAdmin answers lookups from its maps and counts the calls.
+type stubUserAdmin struct {
+	pb.SuperAdminServiceClient
+	users  map[int32]*pb.User
+	admins map[int32]*pb.Admin
+	calls  int
+}
+
+func (c *stubUserAdmin) GetUserByID(ctx context.Context, in *pb.GetUserByIdRequest, opts ...grpc.CallOption) (*pb.GetUserByIdResponse, error) {
+	c.calls++
+	user, ok := c.users[in.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no such user")
+	}
+	return &pb.GetUserByIdResponse{User: user}, nil
+}
+
+func (c *stubUserAdmin) GetAdminByID(ctx context.Context, in *pb.GetAdminByIdRequest, opts ...grpc.CallOption) (*pb.GetAdminByIdResponse, error) {
+	c.calls++
+	admin, ok := c.admins[in.AdminId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no such admin")
+	}
+	return &pb.GetAdminByIdResponse{Admin: admin}, nil
+}
+
+func TestUserDirectoryCachesVerifiedRecords(t *testing.T) {
+	stub := &stubUserAdmin{
+		users:  map[int32]*pb.User{1: {Id: 1, IsVerified: true}, 2: {Id: 2}},
+		admins: map[int32]*pb.Admin{7: {Id: 7, IsVerified: true}, 8: {Id: 8}},
+	}
+	directory := NewUserDirectory(stub, time.Minute)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		lookup    func() error
+		wantCalls int
+	}{
+		{"verified user", func() error { _, err := directory.GetUser(ctx, 1); return err }, 1},
+		{"unverified user", func() error { _, err := directory.GetUser(ctx, 2); return err }, 2},
+		{"unknown user", func() error { _, err := directory.GetUser(ctx, 3); return err }, 2},
+		{"verified admin", func() error { _, err := directory.GetAdmin(ctx, 7); return err }, 1},
+		{"unverified admin", func() error { _, err := directory.GetAdmin(ctx, 8); return err }, 2},
+		{"unknown admin", func() error { _, err := directory.GetAdmin(ctx, 9); return err }, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub.calls = 0
+			tt.lookup()
+			tt.lookup()
+			if stub.calls != tt.wantCalls {
+				t.Fatalf("user-admin got %d calls for two lookups, want %d", stub.calls, tt.wantCalls)
+			}
+		})
+	}
+
+	// An approval shows up on the next lookup.
+	stub.admins[8] = &pb.Admin{Id: 8, IsVerified: true}
+	admin, err := directory.GetAdmin(ctx, 8)
+	if err != nil || !admin.IsVerified {
+		t.Fatalf("GetAdmin after approval = %v, %v", admin, err)
+	}
+}
+
+func TestAllowAllDirectory(t *testing.T) {
+	directory := NewAllowAllDirectory()
+	user, err := directory.GetUser(context.Background(), 42)
+	if err != nil || user.Id != 42 || !user.IsVerified {
+		t.Fatalf("GetUser = %v, %v", user, err)
+	}
+	admin, err := directory.GetAdmin(context.Background(), 7)
+	if err != nil || admin.Id != 7 || !admin.IsVerified {
+		t.Fatalf("GetAdmin = %v, %v", admin, err)
+	}
+}
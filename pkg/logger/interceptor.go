@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestID returns the id assigned to the current request.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, ra := startRequest(ctx, logger, info.FullMethod)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logRequest(ctx, ra, start, err)
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, ra := startRequest(stream.Context(), logger, info.FullMethod)
+		start := time.Now()
+		err := handler(srv, &loggedStream{ServerStream: stream, ctx: ctx})
+		logRequest(ctx, ra, start, err)
+		return err
+	}
+}
+
+// startRequest reuses the caller's request id when one is sent in metadata,
+// echoes it back in the response header and stores the request logger in ctx.
+func startRequest(ctx context.Context, logger *slog.Logger, method string) (context.Context, *requestAttrs) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	ra := &requestAttrs{}
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	ctx = context.WithValue(ctx, requestAttrsKey{}, ra)
+	ctx = WithContext(ctx, logger.With("request_id", requestID, "method", method))
+	return ctx, ra
+}
+
+func logRequest(ctx context.Context, ra *requestAttrs, start time.Time, err error) {
+	code := status.Code(err)
+	ra.mu.Lock()
+	args := append([]any{
+		"duration", time.Since(start),
+		"code", code.String(),
+	}, ra.attrs...)
+	ra.mu.Unlock()
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK:
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+		level = slog.LevelError
+		args = append(args, "error", err.Error())
+	default:
+		level = slog.LevelWarn
+		args = append(args, "error", err.Error())
+	}
+	FromContext(ctx).Log(ctx, level, "rpc completed", args...)
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,69 @@
+// Package logger builds the service's slog logger and carries a request
+// scoped logger through contexts.
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// New builds a logger writing to stdout. Level is one of debug, info, warn
+// or error and format is json or text.
+func New(level, format string) (*slog.Logger, error) {
+	return NewWithWriter(os.Stdout, level, format)
+}
+
+func NewWithWriter(w io.Writer, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+	switch strings.ToLower(format) {
+	case "", "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	}
+	return nil, fmt.Errorf("invalid log format %q", format)
+}
+
+type loggerKey struct{}
+
+// WithContext returns a copy of ctx carrying the logger.
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the request logger, falling back to slog.Default.
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// requestAttrs collects attributes discovered while handling a request, such
+// as the caller's user id, so they end up on the request's access log line.
+type requestAttrs struct {
+	mu    sync.Mutex
+	attrs []any
+}
+
+type requestAttrsKey struct{}
+
+// With adds attributes to the request logger and to the access log line of
+// the current request.
+func With(ctx context.Context, args ...any) context.Context {
+	if ra, ok := ctx.Value(requestAttrsKey{}).(*requestAttrs); ok {
+		ra.mu.Lock()
+		ra.attrs = append(ra.attrs, args...)
+		ra.mu.Unlock()
+	}
+	return WithContext(ctx, FromContext(ctx).With(args...))
+}
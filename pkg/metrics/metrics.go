@@ -0,0 +1,152 @@
+// Package metrics holds the Prometheus collectors exported on /metrics.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "movies_booking"
+
+// Booking events counted by BookingEvent.
+const (
+	BookingCreated   = "created"
+	BookingPaid      = "paid"
+	BookingFailed    = "failed"
+	BookingCancelled = "cancelled"
+)
+
+type Metrics struct {
+	registry     *prometheus.Registry
+	rpcDuration  *prometheus.HistogramVec
+	rpcErrors    *prometheus.CounterVec
+	bookings     *prometheus.CounterVec
+	seatsSold    *prometheus.CounterVec
+	cacheLookups *prometheus.CounterVec
+	breakers     *prometheus.GaugeVec
+	reconciled   *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_handling_seconds",
+			Help:      "Latency of gRPC calls handled by the server.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		rpcErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_errors_total",
+			Help:      "gRPC calls that returned a non OK status.",
+		}, []string{"method", "code"}),
+		bookings: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "bookings_total",
+			Help:      "Booking lifecycle events.",
+		}, []string{"event"}),
+		seatsSold: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "seats_sold_total",
+			Help:      "Seats in paid bookings per showtime.",
+		}, []string{"showtime_id"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "Redis cache lookups by result.",
+		}, []string{"cache", "result"}),
+		breakers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "circuit_breaker_state",
+			Help:      "Circuit breaker state per downstream service: 0 closed, 1 open, 2 half-open.",
+		}, []string{"service"}),
+		reconciled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "payments_reconciled_total",
+			Help:      "Bookings stuck mid-payment handled by the reconciler, by outcome.",
+		}, []string{"result"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcDuration,
+		m.rpcErrors,
+		m.bookings,
+		m.seatsSold,
+		m.cacheLookups,
+		m.breakers,
+		m.reconciled,
+	)
+	return m
+}
+
+// RegisterDB exports the connection pool stats of db.
+func (m *Metrics) RegisterDB(db *sql.DB, name string) error {
+	return m.registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) BookingEvent(event string) {
+	m.bookings.WithLabelValues(event).Inc()
+}
+
+func (m *Metrics) SeatsSold(showtimeId uint, seats int) {
+	m.seatsSold.WithLabelValues(strconv.FormatUint(uint64(showtimeId), 10)).Add(float64(seats))
+}
+
+// CacheLookup records a hit or a miss on the named cache.
+func (m *Metrics) CacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+// CircuitBreakerState records the state of the breaker in front of service.
+func (m *Metrics) CircuitBreakerState(service string, state int) {
+	m.breakers.WithLabelValues(service).Set(float64(state))
+}
+
+func (m *Metrics) PaymentReconciled(result string) {
+	m.reconciled.WithLabelValues(result).Inc()
+}
+
+func (m *Metrics) observeRPC(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	m.rpcDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.rpcErrors.WithLabelValues(method, code).Inc()
+	}
+}
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		m.observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
@@ -0,0 +1,49 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"gorm.io/gorm"
+)
+
+const offsetPrefix = "o:"
+
+// ListOffset pages an already ordered query by position instead of by key.
+// It is meant for orders with no stable key, such as search rank, where an
+// item moving between pages is acceptable.
+func ListOffset[T any](query *gorm.DB, req Request) (*Page[T], error) {
+	offset, err := decodeOffset(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	size := req.size()
+	var items []T
+	if err := query.Offset(offset).Limit(size + 1).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{Items: items}
+	if len(items) > size {
+		page.Items = items[:size]
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(offsetPrefix + strconv.Itoa(offset+size)))
+	}
+	return page, nil
+}
+
+func decodeOffset(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil || !strings.HasPrefix(string(data), offsetPrefix) {
+		return 0, apperrors.InvalidArgument("invalid page token")
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(data), offsetPrefix))
+	if err != nil || offset < 0 {
+		return 0, apperrors.InvalidArgument("invalid page token")
+	}
+	return offset, nil
+}
@@ -0,0 +1,171 @@
+// Package pagination implements cursor based paging, sorting and filtering
+// for list RPCs. The list request messages are shared with other services
+// and have no paging fields, so the options travel as gRPC metadata:
+//
+//	x-page-size:  number of items, default 50, at most 500
+//	x-page-token: the x-next-page-token header of the previous page
+//	x-order-by:   comma separated fields, each optionally followed by desc,
+//	              e.g. "release_date desc, title"
+//	x-filter:     clauses joined by AND, e.g. `genre = Drama AND rating >= 8`.
+//	              Operators are = != > >= < <= and : for a case-insensitive
+//	              substring match. Values may be double quoted.
+//
+// The response carries x-next-page-token as a header when more items exist.
+// A token is only valid with the same order and filter it was issued for.
+package pagination
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+const (
+	PageSizeKey      = "x-page-size"
+	PageTokenKey     = "x-page-token"
+	OrderByKey       = "x-order-by"
+	FilterKey        = "x-filter"
+	NextPageTokenKey = "x-next-page-token"
+)
+
+type Request struct {
+	PageSize  int
+	PageToken string
+	OrderBy   string
+	Filter    string
+}
+
+type Page[T any] struct {
+	Items         []T
+	NextPageToken string
+}
+
+// FromContext reads the paging options from incoming metadata. Missing
+// options select the first page with the default size and order.
+func FromContext(ctx context.Context) (Request, error) {
+	var req Request
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return req, nil
+	}
+	if size := first(md, PageSizeKey); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil || n < 0 {
+			return req, apperrors.InvalidArgument("%s must be a non-negative integer", PageSizeKey)
+		}
+		req.PageSize = n
+	}
+	req.PageToken = first(md, PageTokenKey)
+	req.OrderBy = first(md, OrderByKey)
+	req.Filter = first(md, FilterKey)
+	return req, nil
+}
+
+// SetNextPageToken sends the token for the following page as a response
+// header. It does nothing on the last page.
+func SetNextPageToken(ctx context.Context, token string) error {
+	if token == "" {
+		return nil
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(NextPageTokenKey, token))
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func (r Request) size() int {
+	switch {
+	case r.PageSize == 0:
+		return DefaultPageSize
+	case r.PageSize > MaxPageSize:
+		return MaxPageSize
+	default:
+		return r.PageSize
+	}
+}
+
+// List runs query one page at a time. query should already carry any scoping
+// such as Model, Where or Preload; List adds the filter, order, cursor and
+// limit from req.
+func List[T any](query *gorm.DB, spec Spec[T], req Request) (*Page[T], error) {
+	order, err := spec.parseOrder(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := spec.parseFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range filters {
+		query = query.Where(f.sql, f.value)
+	}
+
+	fingerprint := fingerprint(order, filters)
+	if req.PageToken != "" {
+		values, err := decodeToken(req.PageToken, fingerprint, order)
+		if err != nil {
+			return nil, err
+		}
+		where, args := keyset(order, values)
+		query = query.Where(where, args...)
+	}
+
+	orderBy := make([]string, len(order))
+	for i, key := range order {
+		orderBy[i] = key.field.Column + " " + key.direction()
+	}
+	size := req.size()
+	var items []T
+	if err := query.Order(strings.Join(orderBy, ", ")).Limit(size + 1).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{Items: items}
+	if len(items) > size {
+		page.Items = items[:size]
+		token, err := encodeToken(fingerprint, order, page.Items[size-1])
+		if err != nil {
+			return nil, err
+		}
+		page.NextPageToken = token
+	}
+	return page, nil
+}
+
+// keyset builds the condition selecting rows after the cursor:
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... with < for descending keys.
+func keyset[T any](order []sortKey[T], values []interface{}) (string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+	for i, key := range order {
+		var parts []string
+		for j := 0; j < i; j++ {
+			parts = append(parts, order[j].field.Column+" = ?")
+			args = append(args, values[j])
+		}
+		op := ">"
+		if key.desc {
+			op = "<"
+		}
+		parts = append(parts, key.field.Column+" "+op+" ?")
+		args = append(args, values[i])
+		clauses = append(clauses, "("+strings.Join(parts, " AND ")+")")
+	}
+	return "(" + strings.Join(clauses, " OR ") + ")", args
+}
@@ -0,0 +1,334 @@
+package pagination
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+)
+
+type item struct {
+	ID       uint
+	Title    string
+	Rating   float64
+	Released time.Time
+}
+
+var itemSpec = Spec[item]{
+	Fields: map[string]Field[item]{
+		"title":    {Column: "title", Type: String, Value: func(i item) interface{} { return i.Title }},
+		"rating":   {Column: "rating", Type: Float, Value: func(i item) interface{} { return i.Rating }},
+		"released": {Column: "released", Type: Time, Value: func(i item) interface{} { return i.Released }},
+		"genre":    {Column: "genre", Type: String},
+	},
+	DefaultOrder: "title",
+	ID:           Field[item]{Column: "id", Type: Int, Value: func(i item) interface{} { return i.ID }},
+}
+
+// query is what the fake database was asked.
+type query struct {
+	sql  string
+	vars []interface{}
+}
+
+// fakeDB answers every query with rows, whatever its conditions, and
+// records the SQL it was given in last.
+func fakeDB(t *testing.T, rows []item, last *query) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	err = db.Callback().Query().Replace("gorm:query", func(tx *gorm.DB) {
+		callbacks.BuildQuerySQL(tx)
+		*last = query{sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars}
+		reflect.ValueOf(tx.Statement.Dest).Elem().Set(reflect.ValueOf(append([]item(nil), rows...)))
+	})
+	if err != nil {
+		t.Fatalf("replace query callback: %v", err)
+	}
+	return db.Table("items")
+}
+
+// limit is the LIMIT bound to q, its last variable.
+func limit(q query) int {
+	if len(q.vars) == 0 {
+		return -1
+	}
+	n, _ := q.vars[len(q.vars)-1].(int)
+	return n
+}
+
+func items(n int) []item {
+	rows := make([]item, n)
+	for i := range rows {
+		rows[i] = item{
+			ID:       uint(i + 1),
+			Title:    fmt.Sprintf("title %02d", i+1),
+			Rating:   float64(i) / 2,
+			Released: time.Date(2024, 1, i+1, 12, 0, 0, 0, time.UTC),
+		}
+	}
+	return rows
+}
+
+func wantInvalidArgument(t *testing.T, err error) {
+	t.Helper()
+	if !apperrors.Is(err, apperrors.KindInvalidArgument) {
+		t.Fatalf("error = %v, want invalid argument", err)
+	}
+}
+
+func TestListTokenRoundTrip(t *testing.T) {
+	var last query
+	rows := items(3)
+	req := Request{PageSize: 2, OrderBy: "released desc", Filter: "rating >= 0"}
+
+	page, err := List(fakeDB(t, rows, &last), itemSpec, req)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(page.Items) != 2 || page.NextPageToken == "" {
+		t.Fatalf("first page has %d items and token %q, want 2 items and a token", len(page.Items), page.NextPageToken)
+	}
+	if !strings.HasSuffix(last.sql, "ORDER BY released DESC, id ASC LIMIT $2") || limit(last) != 3 {
+		t.Fatalf("first page query: %s %v", last.sql, last.vars)
+	}
+
+	req.PageToken = page.NextPageToken
+	if _, err := List(fakeDB(t, rows[2:], &last), itemSpec, req); err != nil {
+		t.Fatalf("List with token: %v", err)
+	}
+	// The second page starts after the last item of the first.
+	if !strings.Contains(last.sql, "((released < $2) OR (released = $3 AND id > $4))") {
+		t.Fatalf("second page query: %s", last.sql)
+	}
+	cursor := rows[1]
+	if got := last.vars[1:4]; !reflect.DeepEqual(got, []interface{}{cursor.Released, cursor.Released, int64(cursor.ID)}) {
+		t.Fatalf("cursor values = %v, want those of %+v", got, cursor)
+	}
+}
+
+func TestListLastPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      int
+		wantItems int
+		wantToken bool
+	}{
+		{"empty", 0, 0, false},
+		{"short page", 1, 1, false},
+		{"exactly one page", 2, 2, false},
+		{"one more item", 3, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var last query
+			page, err := List(fakeDB(t, items(tt.rows), &last), itemSpec, Request{PageSize: 2})
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if len(page.Items) != tt.wantItems || (page.NextPageToken != "") != tt.wantToken {
+				t.Fatalf("got %d items and token %q, want %d items and token %t", len(page.Items), page.NextPageToken, tt.wantItems, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestListPageSize(t *testing.T) {
+	tests := []struct {
+		size      int
+		wantLimit int
+	}{
+		{0, DefaultPageSize + 1},
+		{10, 11},
+		{MaxPageSize + 1, MaxPageSize + 1},
+	}
+	for _, tt := range tests {
+		var last query
+		if _, err := List(fakeDB(t, nil, &last), itemSpec, Request{PageSize: tt.size}); err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if got := limit(last); got != tt.wantLimit {
+			t.Errorf("page size %d: limit %d, want %d", tt.size, got, tt.wantLimit)
+		}
+	}
+}
+
+// tamper decodes a token, lets change edit it and encodes it again.
+func tamper(t *testing.T, raw string, change func(tok *token)) string {
+	t.Helper()
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tok token
+	if err := json.Unmarshal(data, &tok); err != nil {
+		t.Fatal(err)
+	}
+	change(&tok)
+	if data, err = json.Marshal(tok); err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func TestListRejectsTamperedToken(t *testing.T) {
+	var last query
+	req := Request{PageSize: 2, OrderBy: "rating"}
+	page, err := List(fakeDB(t, items(3), &last), itemSpec, req)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "!!!"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("not json"))},
+		{"fingerprint changed", tamper(t, page.NextPageToken, func(tok *token) { tok.Fingerprint = "0000000000000000" })},
+		{"value dropped", tamper(t, page.NextPageToken, func(tok *token) { tok.Values = tok.Values[:1] })},
+		{"value of the wrong type", tamper(t, page.NextPageToken, func(tok *token) { tok.Values[0] = "high" })},
+		{"offset token", base64.RawURLEncoding.EncodeToString([]byte("o:2"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := req
+			req.PageToken = tt.token
+			_, err := List(fakeDB(t, nil, &last), itemSpec, req)
+			wantInvalidArgument(t, err)
+		})
+	}
+}
+
+func TestListTokenBoundToOrderAndFilter(t *testing.T) {
+	var last query
+	req := Request{PageSize: 2, OrderBy: "title", Filter: "genre = Drama"}
+	page, err := List(fakeDB(t, items(3), &last), itemSpec, req)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(r *Request)
+	}{
+		{"order field", func(r *Request) { r.OrderBy = "rating" }},
+		{"order direction", func(r *Request) { r.OrderBy = "title desc" }},
+		{"filter value", func(r *Request) { r.Filter = "genre = Comedy" }},
+		{"filter dropped", func(r *Request) { r.Filter = "" }},
+		{"filter added", func(r *Request) { r.Filter = "genre = Drama AND rating > 1" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := req
+			next.PageToken = page.NextPageToken
+			tt.change(&next)
+			_, err := List(fakeDB(t, nil, &last), itemSpec, next)
+			wantInvalidArgument(t, err)
+		})
+	}
+
+	// The same order and filter, spelled differently, still match.
+	next := req
+	next.PageToken = page.NextPageToken
+	next.OrderBy = "title asc"
+	if _, err := List(fakeDB(t, nil, &last), itemSpec, next); err != nil {
+		t.Fatalf("List with an equivalent order: %v", err)
+	}
+}
+
+func TestListRejectsInvalidOrderAndFilter(t *testing.T) {
+	tests := []Request{
+		{OrderBy: "unknown"},
+		{OrderBy: "genre"}, // no Value func, so no cursor
+		{OrderBy: "title sideways"},
+		{Filter: "unknown = 1"},
+		{Filter: "rating = high"},
+		{Filter: "rating : 1"},
+		{Filter: "title"},
+	}
+	for _, req := range tests {
+		var last query
+		_, err := List(fakeDB(t, nil, &last), itemSpec, req)
+		if !apperrors.Is(err, apperrors.KindInvalidArgument) {
+			t.Errorf("List(%+v) error = %v, want invalid argument", req, err)
+		}
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	var last query
+	req := Request{Filter: `title : "50%_off" AND genre != "Sci Fi" AND released >= 2024-01-01`}
+	if _, err := List(fakeDB(t, nil, &last), itemSpec, req); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if !strings.Contains(last.sql, "WHERE title ILIKE $1 AND genre <> $2 AND released >= $3") {
+		t.Fatalf("query: %s", last.sql)
+	}
+	want := []interface{}{`%50\%\_off%`, "Sci Fi", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if !reflect.DeepEqual(last.vars[:3], want) {
+		t.Fatalf("vars = %v, want %v", last.vars[:3], want)
+	}
+}
+
+func TestListOffset(t *testing.T) {
+	var last query
+	page, err := ListOffset[item](fakeDB(t, items(3), &last), Request{PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListOffset: %v", err)
+	}
+	if len(page.Items) != 2 || page.NextPageToken == "" {
+		t.Fatalf("first page has %d items and token %q", len(page.Items), page.NextPageToken)
+	}
+
+	page, err = ListOffset[item](fakeDB(t, items(1), &last), Request{PageSize: 2, PageToken: page.NextPageToken})
+	if err != nil {
+		t.Fatalf("ListOffset with token: %v", err)
+	}
+	if !strings.HasSuffix(last.sql, "LIMIT $1 OFFSET $2") || !reflect.DeepEqual(last.vars, []interface{}{3, 2}) {
+		t.Fatalf("second page query: %s %v", last.sql, last.vars)
+	}
+	if len(page.Items) != 1 || page.NextPageToken != "" {
+		t.Fatalf("last page has %d items and token %q", len(page.Items), page.NextPageToken)
+	}
+
+	for _, raw := range []string{"!!!", base64.RawURLEncoding.EncodeToString([]byte("o:-1")), base64.RawURLEncoding.EncodeToString([]byte("x:2"))} {
+		_, err := ListOffset[item](fakeDB(t, nil, &last), Request{PageToken: raw})
+		wantInvalidArgument(t, err)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		PageSizeKey, " 20 ",
+		PageTokenKey, "token",
+		OrderByKey, "title desc",
+		FilterKey, "genre = Drama",
+	))
+	req, err := FromContext(ctx)
+	if err != nil {
+		t.Fatalf("FromContext: %v", err)
+	}
+	if want := (Request{PageSize: 20, PageToken: "token", OrderBy: "title desc", Filter: "genre = Drama"}); req != want {
+		t.Fatalf("request = %+v, want %+v", req, want)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(PageSizeKey, "-1"))
+	_, err = FromContext(ctx)
+	wantInvalidArgument(t, err)
+}
@@ -0,0 +1,191 @@
+package pagination
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+)
+
+type Type int
+
+const (
+	String Type = iota
+	Int
+	Float
+	Time
+)
+
+// Field maps a name used in x-order-by and x-filter to a SQL expression.
+// Only fields with a Value func can be sorted on, since the cursor stores
+// the last item's value. Sort columns must not be NULL; wrap nullable ones
+// in COALESCE.
+type Field[T any] struct {
+	Column string
+	Type   Type
+	Value  func(T) interface{}
+}
+
+// Spec lists the fields a list RPC exposes. ID must be unique per row and
+// is appended to every order so that the cursor position is exact.
+type Spec[T any] struct {
+	Fields       map[string]Field[T]
+	DefaultOrder string
+	ID           Field[T]
+}
+
+type sortKey[T any] struct {
+	name  string
+	field Field[T]
+	desc  bool
+}
+
+func (k sortKey[T]) direction() string {
+	if k.desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+type filter struct {
+	name  string
+	op    string
+	sql   string
+	value interface{}
+}
+
+func (s Spec[T]) parseOrder(orderBy string) ([]sortKey[T], error) {
+	if strings.TrimSpace(orderBy) == "" {
+		orderBy = s.DefaultOrder
+	}
+	var keys []sortKey[T]
+	for _, part := range strings.Split(orderBy, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			continue
+		}
+		if len(words) > 2 {
+			return nil, apperrors.InvalidArgument("invalid order %q", part)
+		}
+		field, ok := s.Fields[words[0]]
+		if !ok || field.Value == nil {
+			return nil, apperrors.InvalidArgument("cannot order by %q", words[0])
+		}
+		key := sortKey[T]{name: words[0], field: field}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				key.desc = true
+			default:
+				return nil, apperrors.InvalidArgument("invalid order direction %q", words[1])
+			}
+		}
+		keys = append(keys, key)
+	}
+	return append(keys, sortKey[T]{name: "id", field: s.ID}), nil
+}
+
+var clausePattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*(>=|<=|!=|=|>|<|:)\s*(.*)$`)
+
+func (s Spec[T]) parseFilter(expr string) ([]filter, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+	var filters []filter
+	for _, clause := range splitAnd(expr) {
+		f, err := s.parseClause(clause)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func (s Spec[T]) parseClause(clause string) (filter, error) {
+	m := clausePattern.FindStringSubmatch(strings.TrimSpace(clause))
+	if m == nil {
+		return filter{}, apperrors.InvalidArgument("invalid filter clause %q", clause)
+	}
+	name, op, raw := m[1], m[2], m[3]
+	field, ok := s.Fields[name]
+	if !ok {
+		return filter{}, apperrors.InvalidArgument("cannot filter on %q", name)
+	}
+	if unquoted, err := strconv.Unquote(raw); err == nil {
+		raw = unquoted
+	}
+	if op == ":" {
+		if field.Type != String {
+			return filter{}, apperrors.InvalidArgument("%q does not support substring match", name)
+		}
+		return filter{name: name, op: op, sql: field.Column + " ILIKE ?", value: "%" + escapeLike(raw) + "%"}, nil
+	}
+	value, err := parseValue(field.Type, raw)
+	if err != nil {
+		return filter{}, apperrors.InvalidArgument("invalid value for %q: %v", name, err)
+	}
+	sqlOp := op
+	if op == "!=" {
+		sqlOp = "<>"
+	}
+	return filter{name: name, op: op, sql: field.Column + " " + sqlOp + " ?", value: value}, nil
+}
+
+// splitAnd splits on the AND keyword outside double quotes.
+func splitAnd(expr string) []string {
+	var (
+		parts  []string
+		start  int
+		quoted bool
+	)
+	for i := 0; i < len(expr); i++ {
+		switch {
+		case expr[i] == '"' && (i == 0 || expr[i-1] != '\\'):
+			quoted = !quoted
+		case !quoted && isSpace(expr[i]) && i+4 < len(expr) &&
+			strings.EqualFold(expr[i+1:i+4], "and") && isSpace(expr[i+4]):
+			parts = append(parts, expr[start:i])
+			i += 4
+			start = i + 1
+		}
+	}
+	return append(parts, expr[start:])
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func parseValue(t Type, raw string) (interface{}, error) {
+	switch t {
+	case Int:
+		return strconv.ParseInt(raw, 10, 64)
+	case Float:
+		return strconv.ParseFloat(raw, 64)
+	case Time:
+		if v, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+			return v, nil
+		}
+		return time.Parse(time.DateOnly, raw)
+	default:
+		return raw, nil
+	}
+}
+
+func formatValue(t Type, v interface{}) string {
+	if t == Time {
+		if tm, ok := v.(time.Time); ok {
+			return tm.Format(time.RFC3339Nano)
+		}
+	}
+	return fmt.Sprint(v)
+}
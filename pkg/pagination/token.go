@@ -0,0 +1,67 @@
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/aparnasukesh/movies-booking-svc/internal/apperrors"
+)
+
+// token is the cursor handed to clients. It holds the sort values of the
+// last item returned and a fingerprint of the order and filter.
+type token struct {
+	Fingerprint string   `json:"f"`
+	Values      []string `json:"v"`
+}
+
+func fingerprint[T any](order []sortKey[T], filters []filter) string {
+	var b strings.Builder
+	for _, key := range order {
+		b.WriteString(key.name + " " + key.direction() + ",")
+	}
+	b.WriteByte('|')
+	for _, f := range filters {
+		b.WriteString(f.name + f.op + formatValue(String, f.value) + ",")
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodeToken[T any](fingerprint string, order []sortKey[T], last T) (string, error) {
+	t := token{Fingerprint: fingerprint, Values: make([]string, len(order))}
+	for i, key := range order {
+		t.Values[i] = formatValue(key.field.Type, key.field.Value(last))
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeToken[T any](raw, fingerprint string, order []sortKey[T]) ([]interface{}, error) {
+	invalid := apperrors.InvalidArgument("invalid page token")
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, invalid
+	}
+	var t token
+	if err := json.Unmarshal(data, &t); err != nil || len(t.Values) != len(order) {
+		return nil, invalid
+	}
+	if t.Fingerprint != fingerprint {
+		return nil, apperrors.InvalidArgument("page token was issued for a different order or filter")
+	}
+	values := make([]interface{}, len(order))
+	for i, key := range order {
+		v, err := parseValue(key.field.Type, t.Values[i])
+		if err != nil {
+			return nil, invalid
+		}
+		values[i] = v
+	}
+	return values, nil
+}
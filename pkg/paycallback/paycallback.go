@@ -0,0 +1,71 @@
+// Package paycallback signs and verifies the payment result callbacks the
+// payment service sends for bookings. Both sides share a secret; the
+// signature is a hex encoded HMAC-SHA256 over the canonical form of the
+// result.
+package paycallback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid payment callback signature")
+	ErrStale            = errors.New("payment callback timestamp is outside the accepted window")
+)
+
+// Result is the signed content of a callback. Outcome is "SUCCEEDED" or
+// "FAILED".
+type Result struct {
+	BookingID     uint32
+	TransactionID int32
+	OrderID       string
+	Outcome       string
+	Amount        float64
+	Timestamp     time.Time
+}
+
+// Canonical is the byte string that gets signed. Amounts are fixed to two
+// decimals so both sides agree on the formatting.
+func (r Result) Canonical() []byte {
+	return []byte(fmt.Sprintf("v1|%d|%d|%s|%s|%.2f|%d", r.BookingID, r.TransactionID, r.OrderID, r.Outcome, r.Amount, r.Timestamp.Unix()))
+}
+
+func Sign(secret []byte, result Result) string {
+	return hex.EncodeToString(sum(secret, result))
+}
+
+func sum(secret []byte, result Result) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(result.Canonical())
+	return mac.Sum(nil)
+}
+
+// Verifier checks callbacks signed with secret and no older, or further in
+// the future, than maxAge.
+type Verifier struct {
+	secret []byte
+	maxAge time.Duration
+}
+
+func NewVerifier(secret string, maxAge time.Duration) (*Verifier, error) {
+	if secret == "" {
+		return nil, errors.New("payment callback secret is empty")
+	}
+	return &Verifier{secret: []byte(secret), maxAge: maxAge}, nil
+}
+
+func (v *Verifier) Verify(result Result, signature string, now time.Time) error {
+	got, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(got, sum(v.secret, result)) {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(result.Timestamp); age > v.maxAge || age < -v.maxAge {
+		return ErrStale
+	}
+	return nil
+}
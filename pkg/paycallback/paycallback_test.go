@@ -0,0 +1,120 @@
+package paycallback
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-callback-secret"
+
+func testResult(at time.Time) Result {
+	return Result{
+		BookingID:     42,
+		TransactionID: 900,
+		OrderID:       "order-1",
+		Outcome:       "SUCCEEDED",
+		Amount:        300,
+		Timestamp:     at,
+	}
+}
+
+func newTestVerifier(t *testing.T) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(testSecret, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	return v
+}
+
+func TestNewVerifierRequiresSecret(t *testing.T) {
+	if _, err := NewVerifier("", time.Minute); err == nil {
+		t.Fatal("NewVerifier accepted an empty secret")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(time.Now().Unix(), 0)
+	v := newTestVerifier(t)
+	signature := Sign([]byte(testSecret), testResult(now))
+
+	tests := []struct {
+		name      string
+		result    func() Result
+		signature string
+		want      error
+	}{
+		{"valid", func() Result { return testResult(now) }, signature, nil},
+		{"amount changed", func() Result {
+			r := testResult(now)
+			r.Amount = 1
+			return r
+		}, signature, ErrInvalidSignature},
+		{"outcome changed", func() Result {
+			r := testResult(now)
+			r.Outcome = "FAILED"
+			return r
+		}, signature, ErrInvalidSignature},
+		{"booking changed", func() Result {
+			r := testResult(now)
+			r.BookingID = 43
+			return r
+		}, signature, ErrInvalidSignature},
+		{"timestamp changed", func() Result { return testResult(now.Add(time.Second)) }, signature, ErrInvalidSignature},
+		{"other secret", func() Result { return testResult(now) }, Sign([]byte("other-secret"), testResult(now)), ErrInvalidSignature},
+		{"not hex", func() Result { return testResult(now) }, "not-a-signature", ErrInvalidSignature},
+		{"empty signature", func() Result { return testResult(now) }, "", ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.Verify(tt.result(), tt.signature, now); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyTimestampWindow(t *testing.T) {
+	sent := time.Unix(time.Now().Unix(), 0)
+	v := newTestVerifier(t)
+	result := testResult(sent)
+	signature := Sign([]byte(testSecret), result)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want error
+	}{
+		{"at max age", sent.Add(5 * time.Minute), nil},
+		{"older than max age", sent.Add(5*time.Minute + time.Second), ErrStale},
+		{"from the future", sent.Add(-5*time.Minute - time.Second), ErrStale},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.Verify(result, signature, tt.now); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// A captured callback verifies again until it is older than the max age;
+// within that window redeliveries are idempotent on the booking. After it,
+// replaying it is rejected even though the signature is still valid.
+func TestVerifyReplay(t *testing.T) {
+	sent := time.Unix(time.Now().Unix(), 0)
+	v := newTestVerifier(t)
+	result := testResult(sent)
+	signature := Sign([]byte(testSecret), result)
+
+	if err := v.Verify(result, signature, sent.Add(time.Second)); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if err := v.Verify(result, signature, sent.Add(time.Minute)); err != nil {
+		t.Fatalf("redelivery within the max age: %v", err)
+	}
+	if err := v.Verify(result, signature, sent.Add(time.Hour)); !errors.Is(err, ErrStale) {
+		t.Fatalf("replay after the max age = %v, want %v", err, ErrStale)
+	}
+}
@@ -0,0 +1,683 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: pkg/pb/rbac/rbac.proto
+
+package rbac
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TheaterRole int32
+
+const (
+	TheaterRole_THEATER_ROLE_UNSPECIFIED TheaterRole = 0
+	TheaterRole_THEATER_ROLE_STAFF       TheaterRole = 1
+	TheaterRole_THEATER_ROLE_BOX_OFFICE  TheaterRole = 2
+)
+
+// Enum value maps for TheaterRole.
+var (
+	TheaterRole_name = map[int32]string{
+		0: "THEATER_ROLE_UNSPECIFIED",
+		1: "THEATER_ROLE_STAFF",
+		2: "THEATER_ROLE_BOX_OFFICE",
+	}
+	TheaterRole_value = map[string]int32{
+		"THEATER_ROLE_UNSPECIFIED": 0,
+		"THEATER_ROLE_STAFF":       1,
+		"THEATER_ROLE_BOX_OFFICE":  2,
+	}
+)
+
+func (x TheaterRole) Enum() *TheaterRole {
+	p := new(TheaterRole)
+	*p = x
+	return p
+}
+
+func (x TheaterRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TheaterRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_rbac_rbac_proto_enumTypes[0].Descriptor()
+}
+
+func (TheaterRole) Type() protoreflect.EnumType {
+	return &file_pkg_pb_rbac_rbac_proto_enumTypes[0]
+}
+
+func (x TheaterRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TheaterRole.Descriptor instead.
+func (TheaterRole) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{0}
+}
+
+type StaffAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TheaterId  uint32                 `protobuf:"varint,1,opt,name=theater_id,json=theaterId,proto3" json:"theater_id,omitempty"`
+	AdminId    uint32                 `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Role       TheaterRole            `protobuf:"varint,3,opt,name=role,proto3,enum=rbac.TheaterRole" json:"role,omitempty"`
+	AssignedBy uint32                 `protobuf:"varint,4,opt,name=assigned_by,json=assignedBy,proto3" json:"assigned_by,omitempty"`
+	AssignedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+}
+
+func (x *StaffAssignment) Reset() {
+	*x = StaffAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaffAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffAssignment) ProtoMessage() {}
+
+func (x *StaffAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffAssignment.ProtoReflect.Descriptor instead.
+func (*StaffAssignment) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StaffAssignment) GetTheaterId() uint32 {
+	if x != nil {
+		return x.TheaterId
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetAdminId() uint32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetRole() TheaterRole {
+	if x != nil {
+		return x.Role
+	}
+	return TheaterRole_THEATER_ROLE_UNSPECIFIED
+}
+
+func (x *StaffAssignment) GetAssignedBy() uint32 {
+	if x != nil {
+		return x.AssignedBy
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+type AssignTheaterStaffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TheaterId uint32      `protobuf:"varint,1,opt,name=theater_id,json=theaterId,proto3" json:"theater_id,omitempty"`
+	AdminId   uint32      `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Role      TheaterRole `protobuf:"varint,3,opt,name=role,proto3,enum=rbac.TheaterRole" json:"role,omitempty"`
+}
+
+func (x *AssignTheaterStaffRequest) Reset() {
+	*x = AssignTheaterStaffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignTheaterStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTheaterStaffRequest) ProtoMessage() {}
+
+func (x *AssignTheaterStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTheaterStaffRequest.ProtoReflect.Descriptor instead.
+func (*AssignTheaterStaffRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssignTheaterStaffRequest) GetTheaterId() uint32 {
+	if x != nil {
+		return x.TheaterId
+	}
+	return 0
+}
+
+func (x *AssignTheaterStaffRequest) GetAdminId() uint32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AssignTheaterStaffRequest) GetRole() TheaterRole {
+	if x != nil {
+		return x.Role
+	}
+	return TheaterRole_THEATER_ROLE_UNSPECIFIED
+}
+
+type AssignTheaterStaffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignment *StaffAssignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+}
+
+func (x *AssignTheaterStaffResponse) Reset() {
+	*x = AssignTheaterStaffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignTheaterStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTheaterStaffResponse) ProtoMessage() {}
+
+func (x *AssignTheaterStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTheaterStaffResponse.ProtoReflect.Descriptor instead.
+func (*AssignTheaterStaffResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AssignTheaterStaffResponse) GetAssignment() *StaffAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type RemoveTheaterStaffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TheaterId uint32 `protobuf:"varint,1,opt,name=theater_id,json=theaterId,proto3" json:"theater_id,omitempty"`
+	AdminId   uint32 `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+}
+
+func (x *RemoveTheaterStaffRequest) Reset() {
+	*x = RemoveTheaterStaffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTheaterStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTheaterStaffRequest) ProtoMessage() {}
+
+func (x *RemoveTheaterStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTheaterStaffRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTheaterStaffRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveTheaterStaffRequest) GetTheaterId() uint32 {
+	if x != nil {
+		return x.TheaterId
+	}
+	return 0
+}
+
+func (x *RemoveTheaterStaffRequest) GetAdminId() uint32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+type RemoveTheaterStaffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveTheaterStaffResponse) Reset() {
+	*x = RemoveTheaterStaffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTheaterStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTheaterStaffResponse) ProtoMessage() {}
+
+func (x *RemoveTheaterStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTheaterStaffResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTheaterStaffResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{4}
+}
+
+type ListTheaterStaffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TheaterId uint32 `protobuf:"varint,1,opt,name=theater_id,json=theaterId,proto3" json:"theater_id,omitempty"`
+}
+
+func (x *ListTheaterStaffRequest) Reset() {
+	*x = ListTheaterStaffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTheaterStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTheaterStaffRequest) ProtoMessage() {}
+
+func (x *ListTheaterStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTheaterStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListTheaterStaffRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTheaterStaffRequest) GetTheaterId() uint32 {
+	if x != nil {
+		return x.TheaterId
+	}
+	return 0
+}
+
+type ListTheaterStaffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*StaffAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *ListTheaterStaffResponse) Reset() {
+	*x = ListTheaterStaffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTheaterStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTheaterStaffResponse) ProtoMessage() {}
+
+func (x *ListTheaterStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_rbac_rbac_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTheaterStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListTheaterStaffResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_rbac_rbac_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTheaterStaffResponse) GetAssignments() []*StaffAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+var File_pkg_pb_rbac_rbac_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_rbac_rbac_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x62, 0x61, 0x63, 0x2f, 0x72, 0x62,
+	0x61, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x72, 0x62, 0x61, 0x63, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xd0, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x62,
+	0x61, 0x63, 0x2e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x7c, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x68, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x54,
+	0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x22, 0x53, 0x0a, 0x1a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x60, 0x0a, 0x0b, 0x54, 0x68, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x48, 0x45, 0x41,
+	0x54, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x48, 0x45, 0x41, 0x54, 0x45,
+	0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x10, 0x01, 0x12, 0x1b,
+	0x0a, 0x17, 0x54, 0x48, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x42,
+	0x4f, 0x58, 0x5f, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x45, 0x10, 0x02, 0x32, 0x93, 0x02, 0x0a, 0x0c,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x12,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x12, 0x1f, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12, 0x1f, 0x2e, 0x72, 0x62,
+	0x61, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72,
+	0x62, 0x61, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x12, 0x1d, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x62, 0x61, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x70, 0x61, 0x72, 0x6e, 0x61, 0x73, 0x75, 0x6b, 0x65, 0x73, 0x68, 0x2f, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x73, 0x2d, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x76, 0x63, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x62, 0x61, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_pb_rbac_rbac_proto_rawDescOnce sync.Once
+	file_pkg_pb_rbac_rbac_proto_rawDescData = file_pkg_pb_rbac_rbac_proto_rawDesc
+)
+
+func file_pkg_pb_rbac_rbac_proto_rawDescGZIP() []byte {
+	file_pkg_pb_rbac_rbac_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_rbac_rbac_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_rbac_rbac_proto_rawDescData)
+	})
+	return file_pkg_pb_rbac_rbac_proto_rawDescData
+}
+
+var file_pkg_pb_rbac_rbac_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_pb_rbac_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pkg_pb_rbac_rbac_proto_goTypes = []any{
+	(TheaterRole)(0),                   // 0: rbac.TheaterRole
+	(*StaffAssignment)(nil),            // 1: rbac.StaffAssignment
+	(*AssignTheaterStaffRequest)(nil),  // 2: rbac.AssignTheaterStaffRequest
+	(*AssignTheaterStaffResponse)(nil), // 3: rbac.AssignTheaterStaffResponse
+	(*RemoveTheaterStaffRequest)(nil),  // 4: rbac.RemoveTheaterStaffRequest
+	(*RemoveTheaterStaffResponse)(nil), // 5: rbac.RemoveTheaterStaffResponse
+	(*ListTheaterStaffRequest)(nil),    // 6: rbac.ListTheaterStaffRequest
+	(*ListTheaterStaffResponse)(nil),   // 7: rbac.ListTheaterStaffResponse
+	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+}
+var file_pkg_pb_rbac_rbac_proto_depIdxs = []int32{
+	0, // 0: rbac.StaffAssignment.role:type_name -> rbac.TheaterRole
+	8, // 1: rbac.StaffAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	0, // 2: rbac.AssignTheaterStaffRequest.role:type_name -> rbac.TheaterRole
+	1, // 3: rbac.AssignTheaterStaffResponse.assignment:type_name -> rbac.StaffAssignment
+	1, // 4: rbac.ListTheaterStaffResponse.assignments:type_name -> rbac.StaffAssignment
+	2, // 5: rbac.StaffService.AssignTheaterStaff:input_type -> rbac.AssignTheaterStaffRequest
+	4, // 6: rbac.StaffService.RemoveTheaterStaff:input_type -> rbac.RemoveTheaterStaffRequest
+	6, // 7: rbac.StaffService.ListTheaterStaff:input_type -> rbac.ListTheaterStaffRequest
+	3, // 8: rbac.StaffService.AssignTheaterStaff:output_type -> rbac.AssignTheaterStaffResponse
+	5, // 9: rbac.StaffService.RemoveTheaterStaff:output_type -> rbac.RemoveTheaterStaffResponse
+	7, // 10: rbac.StaffService.ListTheaterStaff:output_type -> rbac.ListTheaterStaffResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_rbac_rbac_proto_init() }
+func file_pkg_pb_rbac_rbac_proto_init() {
+	if File_pkg_pb_rbac_rbac_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_pb_rbac_rbac_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*StaffAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_rbac_rbac_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AssignTheaterStaffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_rbac_rbac_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AssignTheaterStaffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_rbac_rbac_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveTheaterStaffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_rbac_rbac_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveTheaterStaffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_rbac_rbac_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTheaterStaffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_rbac_rbac_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTheaterStaffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_pb_rbac_rbac_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_pb_rbac_rbac_proto_goTypes,
+		DependencyIndexes: file_pkg_pb_rbac_rbac_proto_depIdxs,
+		EnumInfos:         file_pkg_pb_rbac_rbac_proto_enumTypes,
+		MessageInfos:      file_pkg_pb_rbac_rbac_proto_msgTypes,
+	}.Build()
+	File_pkg_pb_rbac_rbac_proto = out.File
+	file_pkg_pb_rbac_rbac_proto_rawDesc = nil
+	file_pkg_pb_rbac_rbac_proto_goTypes = nil
+	file_pkg_pb_rbac_rbac_proto_depIdxs = nil
+}
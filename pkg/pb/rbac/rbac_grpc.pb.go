@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pkg/pb/rbac/rbac.proto
+
+package rbac
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StaffService_AssignTheaterStaff_FullMethodName = "/rbac.StaffService/AssignTheaterStaff"
+	StaffService_RemoveTheaterStaff_FullMethodName = "/rbac.StaffService/RemoveTheaterStaff"
+	StaffService_ListTheaterStaff_FullMethodName   = "/rbac.StaffService/ListTheaterStaff"
+)
+
+// StaffServiceClient is the client API for StaffService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StaffServiceClient interface {
+	AssignTheaterStaff(ctx context.Context, in *AssignTheaterStaffRequest, opts ...grpc.CallOption) (*AssignTheaterStaffResponse, error)
+	RemoveTheaterStaff(ctx context.Context, in *RemoveTheaterStaffRequest, opts ...grpc.CallOption) (*RemoveTheaterStaffResponse, error)
+	ListTheaterStaff(ctx context.Context, in *ListTheaterStaffRequest, opts ...grpc.CallOption) (*ListTheaterStaffResponse, error)
+}
+
+type staffServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStaffServiceClient(cc grpc.ClientConnInterface) StaffServiceClient {
+	return &staffServiceClient{cc}
+}
+
+func (c *staffServiceClient) AssignTheaterStaff(ctx context.Context, in *AssignTheaterStaffRequest, opts ...grpc.CallOption) (*AssignTheaterStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignTheaterStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_AssignTheaterStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RemoveTheaterStaff(ctx context.Context, in *RemoveTheaterStaffRequest, opts ...grpc.CallOption) (*RemoveTheaterStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTheaterStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_RemoveTheaterStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListTheaterStaff(ctx context.Context, in *ListTheaterStaffRequest, opts ...grpc.CallOption) (*ListTheaterStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTheaterStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_ListTheaterStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StaffServiceServer is the server API for StaffService service.
+// All implementations must embed UnimplementedStaffServiceServer
+// for forward compatibility.
+type StaffServiceServer interface {
+	AssignTheaterStaff(context.Context, *AssignTheaterStaffRequest) (*AssignTheaterStaffResponse, error)
+	RemoveTheaterStaff(context.Context, *RemoveTheaterStaffRequest) (*RemoveTheaterStaffResponse, error)
+	ListTheaterStaff(context.Context, *ListTheaterStaffRequest) (*ListTheaterStaffResponse, error)
+	mustEmbedUnimplementedStaffServiceServer()
+}
+
+// UnimplementedStaffServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStaffServiceServer struct{}
+
+func (UnimplementedStaffServiceServer) AssignTheaterStaff(context.Context, *AssignTheaterStaffRequest) (*AssignTheaterStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTheaterStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) RemoveTheaterStaff(context.Context, *RemoveTheaterStaffRequest) (*RemoveTheaterStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTheaterStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) ListTheaterStaff(context.Context, *ListTheaterStaffRequest) (*ListTheaterStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTheaterStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) mustEmbedUnimplementedStaffServiceServer() {}
+func (UnimplementedStaffServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeStaffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StaffServiceServer will
+// result in compilation errors.
+type UnsafeStaffServiceServer interface {
+	mustEmbedUnimplementedStaffServiceServer()
+}
+
+func RegisterStaffServiceServer(s grpc.ServiceRegistrar, srv StaffServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStaffServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StaffService_ServiceDesc, srv)
+}
+
+func _StaffService_AssignTheaterStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTheaterStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).AssignTheaterStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_AssignTheaterStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).AssignTheaterStaff(ctx, req.(*AssignTheaterStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RemoveTheaterStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTheaterStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RemoveTheaterStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RemoveTheaterStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RemoveTheaterStaff(ctx, req.(*RemoveTheaterStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListTheaterStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTheaterStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListTheaterStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListTheaterStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListTheaterStaff(ctx, req.(*ListTheaterStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StaffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rbac.StaffService",
+	HandlerType: (*StaffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AssignTheaterStaff",
+			Handler:    _StaffService_AssignTheaterStaff_Handler,
+		},
+		{
+			MethodName: "RemoveTheaterStaff",
+			Handler:    _StaffService_RemoveTheaterStaff_Handler,
+		},
+		{
+			MethodName: "ListTheaterStaff",
+			Handler:    _StaffService_ListTheaterStaff_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/pb/rbac/rbac.proto",
+}
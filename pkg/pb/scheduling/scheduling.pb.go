@@ -0,0 +1,870 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: pkg/pb/scheduling/scheduling.proto
+
+package scheduling
+
+import (
+	catalog "github.com/aparnasukesh/movies-booking-svc/pkg/pb/catalog"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetScreenTypeFormatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreenTypeId uint32 `protobuf:"varint,1,opt,name=screen_type_id,json=screenTypeId,proto3" json:"screen_type_id,omitempty"`
+	// Replaces the formats the screen type can project. A screen type with
+	// no formats only shows 2D.
+	Formats []catalog.Format `protobuf:"varint,2,rep,packed,name=formats,proto3,enum=catalog.Format" json:"formats,omitempty"`
+}
+
+func (x *SetScreenTypeFormatsRequest) Reset() {
+	*x = SetScreenTypeFormatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScreenTypeFormatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScreenTypeFormatsRequest) ProtoMessage() {}
+
+func (x *SetScreenTypeFormatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScreenTypeFormatsRequest.ProtoReflect.Descriptor instead.
+func (*SetScreenTypeFormatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetScreenTypeFormatsRequest) GetScreenTypeId() uint32 {
+	if x != nil {
+		return x.ScreenTypeId
+	}
+	return 0
+}
+
+func (x *SetScreenTypeFormatsRequest) GetFormats() []catalog.Format {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+type SetScreenTypeFormatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetScreenTypeFormatsResponse) Reset() {
+	*x = SetScreenTypeFormatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScreenTypeFormatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScreenTypeFormatsResponse) ProtoMessage() {}
+
+func (x *SetScreenTypeFormatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScreenTypeFormatsResponse.ProtoReflect.Descriptor instead.
+func (*SetScreenTypeFormatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{1}
+}
+
+type GetScreenTypeFormatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreenTypeId uint32 `protobuf:"varint,1,opt,name=screen_type_id,json=screenTypeId,proto3" json:"screen_type_id,omitempty"`
+}
+
+func (x *GetScreenTypeFormatsRequest) Reset() {
+	*x = GetScreenTypeFormatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreenTypeFormatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreenTypeFormatsRequest) ProtoMessage() {}
+
+func (x *GetScreenTypeFormatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreenTypeFormatsRequest.ProtoReflect.Descriptor instead.
+func (*GetScreenTypeFormatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetScreenTypeFormatsRequest) GetScreenTypeId() uint32 {
+	if x != nil {
+		return x.ScreenTypeId
+	}
+	return 0
+}
+
+type GetScreenTypeFormatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formats []catalog.Format `protobuf:"varint,1,rep,packed,name=formats,proto3,enum=catalog.Format" json:"formats,omitempty"`
+}
+
+func (x *GetScreenTypeFormatsResponse) Reset() {
+	*x = GetScreenTypeFormatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreenTypeFormatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreenTypeFormatsResponse) ProtoMessage() {}
+
+func (x *GetScreenTypeFormatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreenTypeFormatsResponse.ProtoReflect.Descriptor instead.
+func (*GetScreenTypeFormatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetScreenTypeFormatsResponse) GetFormats() []catalog.Format {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+type AddShowtimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MovieVersionId uint32                 `protobuf:"varint,1,opt,name=movie_version_id,json=movieVersionId,proto3" json:"movie_version_id,omitempty"`
+	ScreenId       uint32                 `protobuf:"varint,2,opt,name=screen_id,json=screenId,proto3" json:"screen_id,omitempty"`
+	ShowDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=show_date,json=showDate,proto3" json:"show_date,omitempty"`
+	ShowTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=show_time,json=showTime,proto3" json:"show_time,omitempty"`
+}
+
+func (x *AddShowtimeRequest) Reset() {
+	*x = AddShowtimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddShowtimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShowtimeRequest) ProtoMessage() {}
+
+func (x *AddShowtimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShowtimeRequest.ProtoReflect.Descriptor instead.
+func (*AddShowtimeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddShowtimeRequest) GetMovieVersionId() uint32 {
+	if x != nil {
+		return x.MovieVersionId
+	}
+	return 0
+}
+
+func (x *AddShowtimeRequest) GetScreenId() uint32 {
+	if x != nil {
+		return x.ScreenId
+	}
+	return 0
+}
+
+func (x *AddShowtimeRequest) GetShowDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShowDate
+	}
+	return nil
+}
+
+func (x *AddShowtimeRequest) GetShowTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShowTime
+	}
+	return nil
+}
+
+type AddShowtimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShowtimeId uint32 `protobuf:"varint,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+}
+
+func (x *AddShowtimeResponse) Reset() {
+	*x = AddShowtimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddShowtimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShowtimeResponse) ProtoMessage() {}
+
+func (x *AddShowtimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShowtimeResponse.ProtoReflect.Descriptor instead.
+func (*AddShowtimeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddShowtimeResponse) GetShowtimeId() uint32 {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return 0
+}
+
+type SetShowtimeVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShowtimeId uint32 `protobuf:"varint,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	// Must be a version of the showtime's movie.
+	MovieVersionId uint32 `protobuf:"varint,2,opt,name=movie_version_id,json=movieVersionId,proto3" json:"movie_version_id,omitempty"`
+}
+
+func (x *SetShowtimeVersionRequest) Reset() {
+	*x = SetShowtimeVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetShowtimeVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShowtimeVersionRequest) ProtoMessage() {}
+
+func (x *SetShowtimeVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShowtimeVersionRequest.ProtoReflect.Descriptor instead.
+func (*SetShowtimeVersionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetShowtimeVersionRequest) GetShowtimeId() uint32 {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return 0
+}
+
+func (x *SetShowtimeVersionRequest) GetMovieVersionId() uint32 {
+	if x != nil {
+		return x.MovieVersionId
+	}
+	return 0
+}
+
+type SetShowtimeVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetShowtimeVersionResponse) Reset() {
+	*x = SetShowtimeVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetShowtimeVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShowtimeVersionResponse) ProtoMessage() {}
+
+func (x *SetShowtimeVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShowtimeVersionResponse.ProtoReflect.Descriptor instead.
+func (*SetShowtimeVersionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{7}
+}
+
+type GetShowtimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShowtimeId uint32 `protobuf:"varint,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+}
+
+func (x *GetShowtimeRequest) Reset() {
+	*x = GetShowtimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShowtimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShowtimeRequest) ProtoMessage() {}
+
+func (x *GetShowtimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShowtimeRequest.ProtoReflect.Descriptor instead.
+func (*GetShowtimeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetShowtimeRequest) GetShowtimeId() uint32 {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return 0
+}
+
+type GetShowtimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShowtimeId uint32                 `protobuf:"varint,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	MovieId    uint32                 `protobuf:"varint,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	ScreenId   uint32                 `protobuf:"varint,3,opt,name=screen_id,json=screenId,proto3" json:"screen_id,omitempty"`
+	ShowDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=show_date,json=showDate,proto3" json:"show_date,omitempty"`
+	ShowTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=show_time,json=showTime,proto3" json:"show_time,omitempty"`
+	Version    *catalog.MovieVersion  `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetShowtimeResponse) Reset() {
+	*x = GetShowtimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShowtimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShowtimeResponse) ProtoMessage() {}
+
+func (x *GetShowtimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_scheduling_scheduling_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShowtimeResponse.ProtoReflect.Descriptor instead.
+func (*GetShowtimeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetShowtimeResponse) GetShowtimeId() uint32 {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return 0
+}
+
+func (x *GetShowtimeResponse) GetMovieId() uint32 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+func (x *GetShowtimeResponse) GetScreenId() uint32 {
+	if x != nil {
+		return x.ScreenId
+	}
+	return 0
+}
+
+func (x *GetShowtimeResponse) GetShowDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShowDate
+	}
+	return nil
+}
+
+func (x *GetShowtimeResponse) GetShowTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShowTime
+	}
+	return nil
+}
+
+func (x *GetShowtimeResponse) GetVersion() *catalog.MovieVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+var File_pkg_pb_scheduling_scheduling_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_scheduling_scheduling_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x69, 0x6e, 0x67, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x6e, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x22,
+	0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x43, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x22,
+	0xcd, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x68,
+	0x6f, 0x77, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x36, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x68, 0x6f,
+	0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x53, 0x68,
+	0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74,
+	0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69,
+	0x6d, 0x65, 0x49, 0x64, 0x22, 0x91, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73,
+	0x68, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xee, 0x03, 0x0a, 0x11, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x73, 0x12, 0x27, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74,
+	0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74,
+	0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x61, 0x72, 0x6e, 0x61, 0x73, 0x75,
+	0x6b, 0x65, 0x73, 0x68, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x2d, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2d, 0x73, 0x76, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pkg_pb_scheduling_scheduling_proto_rawDescOnce sync.Once
+	file_pkg_pb_scheduling_scheduling_proto_rawDescData = file_pkg_pb_scheduling_scheduling_proto_rawDesc
+)
+
+func file_pkg_pb_scheduling_scheduling_proto_rawDescGZIP() []byte {
+	file_pkg_pb_scheduling_scheduling_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_scheduling_scheduling_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_scheduling_scheduling_proto_rawDescData)
+	})
+	return file_pkg_pb_scheduling_scheduling_proto_rawDescData
+}
+
+var file_pkg_pb_scheduling_scheduling_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_pkg_pb_scheduling_scheduling_proto_goTypes = []any{
+	(*SetScreenTypeFormatsRequest)(nil),  // 0: scheduling.SetScreenTypeFormatsRequest
+	(*SetScreenTypeFormatsResponse)(nil), // 1: scheduling.SetScreenTypeFormatsResponse
+	(*GetScreenTypeFormatsRequest)(nil),  // 2: scheduling.GetScreenTypeFormatsRequest
+	(*GetScreenTypeFormatsResponse)(nil), // 3: scheduling.GetScreenTypeFormatsResponse
+	(*AddShowtimeRequest)(nil),           // 4: scheduling.AddShowtimeRequest
+	(*AddShowtimeResponse)(nil),          // 5: scheduling.AddShowtimeResponse
+	(*SetShowtimeVersionRequest)(nil),    // 6: scheduling.SetShowtimeVersionRequest
+	(*SetShowtimeVersionResponse)(nil),   // 7: scheduling.SetShowtimeVersionResponse
+	(*GetShowtimeRequest)(nil),           // 8: scheduling.GetShowtimeRequest
+	(*GetShowtimeResponse)(nil),          // 9: scheduling.GetShowtimeResponse
+	(catalog.Format)(0),                  // 10: catalog.Format
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+	(*catalog.MovieVersion)(nil),         // 12: catalog.MovieVersion
+}
+var file_pkg_pb_scheduling_scheduling_proto_depIdxs = []int32{
+	10, // 0: scheduling.SetScreenTypeFormatsRequest.formats:type_name -> catalog.Format
+	10, // 1: scheduling.GetScreenTypeFormatsResponse.formats:type_name -> catalog.Format
+	11, // 2: scheduling.AddShowtimeRequest.show_date:type_name -> google.protobuf.Timestamp
+	11, // 3: scheduling.AddShowtimeRequest.show_time:type_name -> google.protobuf.Timestamp
+	11, // 4: scheduling.GetShowtimeResponse.show_date:type_name -> google.protobuf.Timestamp
+	11, // 5: scheduling.GetShowtimeResponse.show_time:type_name -> google.protobuf.Timestamp
+	12, // 6: scheduling.GetShowtimeResponse.version:type_name -> catalog.MovieVersion
+	0,  // 7: scheduling.SchedulingService.SetScreenTypeFormats:input_type -> scheduling.SetScreenTypeFormatsRequest
+	2,  // 8: scheduling.SchedulingService.GetScreenTypeFormats:input_type -> scheduling.GetScreenTypeFormatsRequest
+	4,  // 9: scheduling.SchedulingService.AddShowtime:input_type -> scheduling.AddShowtimeRequest
+	6,  // 10: scheduling.SchedulingService.SetShowtimeVersion:input_type -> scheduling.SetShowtimeVersionRequest
+	8,  // 11: scheduling.SchedulingService.GetShowtime:input_type -> scheduling.GetShowtimeRequest
+	1,  // 12: scheduling.SchedulingService.SetScreenTypeFormats:output_type -> scheduling.SetScreenTypeFormatsResponse
+	3,  // 13: scheduling.SchedulingService.GetScreenTypeFormats:output_type -> scheduling.GetScreenTypeFormatsResponse
+	5,  // 14: scheduling.SchedulingService.AddShowtime:output_type -> scheduling.AddShowtimeResponse
+	7,  // 15: scheduling.SchedulingService.SetShowtimeVersion:output_type -> scheduling.SetShowtimeVersionResponse
+	9,  // 16: scheduling.SchedulingService.GetShowtime:output_type -> scheduling.GetShowtimeResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_scheduling_scheduling_proto_init() }
+func file_pkg_pb_scheduling_scheduling_proto_init() {
+	if File_pkg_pb_scheduling_scheduling_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SetScreenTypeFormatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SetScreenTypeFormatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetScreenTypeFormatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetScreenTypeFormatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AddShowtimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AddShowtimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*SetShowtimeVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SetShowtimeVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GetShowtimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_scheduling_scheduling_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetShowtimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_pb_scheduling_scheduling_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_pb_scheduling_scheduling_proto_goTypes,
+		DependencyIndexes: file_pkg_pb_scheduling_scheduling_proto_depIdxs,
+		MessageInfos:      file_pkg_pb_scheduling_scheduling_proto_msgTypes,
+	}.Build()
+	File_pkg_pb_scheduling_scheduling_proto = out.File
+	file_pkg_pb_scheduling_scheduling_proto_rawDesc = nil
+	file_pkg_pb_scheduling_scheduling_proto_goTypes = nil
+	file_pkg_pb_scheduling_scheduling_proto_depIdxs = nil
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pkg/pb/scheduling/scheduling.proto
+
+package scheduling
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchedulingService_SetScreenTypeFormats_FullMethodName = "/scheduling.SchedulingService/SetScreenTypeFormats"
+	SchedulingService_GetScreenTypeFormats_FullMethodName = "/scheduling.SchedulingService/GetScreenTypeFormats"
+	SchedulingService_AddShowtime_FullMethodName          = "/scheduling.SchedulingService/AddShowtime"
+	SchedulingService_SetShowtimeVersion_FullMethodName   = "/scheduling.SchedulingService/SetShowtimeVersion"
+	SchedulingService_GetShowtime_FullMethodName          = "/scheduling.SchedulingService/GetShowtime"
+)
+
+// SchedulingServiceClient is the client API for SchedulingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SchedulingService schedules movie versions on screens. The TheatreService
+// showtime RPCs keep working and use the movie's default version.
+type SchedulingServiceClient interface {
+	SetScreenTypeFormats(ctx context.Context, in *SetScreenTypeFormatsRequest, opts ...grpc.CallOption) (*SetScreenTypeFormatsResponse, error)
+	GetScreenTypeFormats(ctx context.Context, in *GetScreenTypeFormatsRequest, opts ...grpc.CallOption) (*GetScreenTypeFormatsResponse, error)
+	AddShowtime(ctx context.Context, in *AddShowtimeRequest, opts ...grpc.CallOption) (*AddShowtimeResponse, error)
+	SetShowtimeVersion(ctx context.Context, in *SetShowtimeVersionRequest, opts ...grpc.CallOption) (*SetShowtimeVersionResponse, error)
+	GetShowtime(ctx context.Context, in *GetShowtimeRequest, opts ...grpc.CallOption) (*GetShowtimeResponse, error)
+}
+
+type schedulingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulingServiceClient(cc grpc.ClientConnInterface) SchedulingServiceClient {
+	return &schedulingServiceClient{cc}
+}
+
+func (c *schedulingServiceClient) SetScreenTypeFormats(ctx context.Context, in *SetScreenTypeFormatsRequest, opts ...grpc.CallOption) (*SetScreenTypeFormatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetScreenTypeFormatsResponse)
+	err := c.cc.Invoke(ctx, SchedulingService_SetScreenTypeFormats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingServiceClient) GetScreenTypeFormats(ctx context.Context, in *GetScreenTypeFormatsRequest, opts ...grpc.CallOption) (*GetScreenTypeFormatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScreenTypeFormatsResponse)
+	err := c.cc.Invoke(ctx, SchedulingService_GetScreenTypeFormats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingServiceClient) AddShowtime(ctx context.Context, in *AddShowtimeRequest, opts ...grpc.CallOption) (*AddShowtimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddShowtimeResponse)
+	err := c.cc.Invoke(ctx, SchedulingService_AddShowtime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingServiceClient) SetShowtimeVersion(ctx context.Context, in *SetShowtimeVersionRequest, opts ...grpc.CallOption) (*SetShowtimeVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetShowtimeVersionResponse)
+	err := c.cc.Invoke(ctx, SchedulingService_SetShowtimeVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingServiceClient) GetShowtime(ctx context.Context, in *GetShowtimeRequest, opts ...grpc.CallOption) (*GetShowtimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShowtimeResponse)
+	err := c.cc.Invoke(ctx, SchedulingService_GetShowtime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulingServiceServer is the server API for SchedulingService service.
+// All implementations must embed UnimplementedSchedulingServiceServer
+// for forward compatibility.
+//
+// SchedulingService schedules movie versions on screens. The TheatreService
+// showtime RPCs keep working and use the movie's default version.
+type SchedulingServiceServer interface {
+	SetScreenTypeFormats(context.Context, *SetScreenTypeFormatsRequest) (*SetScreenTypeFormatsResponse, error)
+	GetScreenTypeFormats(context.Context, *GetScreenTypeFormatsRequest) (*GetScreenTypeFormatsResponse, error)
+	AddShowtime(context.Context, *AddShowtimeRequest) (*AddShowtimeResponse, error)
+	SetShowtimeVersion(context.Context, *SetShowtimeVersionRequest) (*SetShowtimeVersionResponse, error)
+	GetShowtime(context.Context, *GetShowtimeRequest) (*GetShowtimeResponse, error)
+	mustEmbedUnimplementedSchedulingServiceServer()
+}
+
+// UnimplementedSchedulingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchedulingServiceServer struct{}
+
+func (UnimplementedSchedulingServiceServer) SetScreenTypeFormats(context.Context, *SetScreenTypeFormatsRequest) (*SetScreenTypeFormatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScreenTypeFormats not implemented")
+}
+func (UnimplementedSchedulingServiceServer) GetScreenTypeFormats(context.Context, *GetScreenTypeFormatsRequest) (*GetScreenTypeFormatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScreenTypeFormats not implemented")
+}
+func (UnimplementedSchedulingServiceServer) AddShowtime(context.Context, *AddShowtimeRequest) (*AddShowtimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShowtime not implemented")
+}
+func (UnimplementedSchedulingServiceServer) SetShowtimeVersion(context.Context, *SetShowtimeVersionRequest) (*SetShowtimeVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShowtimeVersion not implemented")
+}
+func (UnimplementedSchedulingServiceServer) GetShowtime(context.Context, *GetShowtimeRequest) (*GetShowtimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShowtime not implemented")
+}
+func (UnimplementedSchedulingServiceServer) mustEmbedUnimplementedSchedulingServiceServer() {}
+func (UnimplementedSchedulingServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSchedulingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulingServiceServer will
+// result in compilation errors.
+type UnsafeSchedulingServiceServer interface {
+	mustEmbedUnimplementedSchedulingServiceServer()
+}
+
+func RegisterSchedulingServiceServer(s grpc.ServiceRegistrar, srv SchedulingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSchedulingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchedulingService_ServiceDesc, srv)
+}
+
+func _SchedulingService_SetScreenTypeFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScreenTypeFormatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServiceServer).SetScreenTypeFormats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulingService_SetScreenTypeFormats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServiceServer).SetScreenTypeFormats(ctx, req.(*SetScreenTypeFormatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulingService_GetScreenTypeFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScreenTypeFormatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServiceServer).GetScreenTypeFormats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulingService_GetScreenTypeFormats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServiceServer).GetScreenTypeFormats(ctx, req.(*GetScreenTypeFormatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulingService_AddShowtime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddShowtimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServiceServer).AddShowtime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulingService_AddShowtime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServiceServer).AddShowtime(ctx, req.(*AddShowtimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulingService_SetShowtimeVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShowtimeVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServiceServer).SetShowtimeVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulingService_SetShowtimeVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServiceServer).SetShowtimeVersion(ctx, req.(*SetShowtimeVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulingService_GetShowtime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShowtimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServiceServer).GetShowtime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulingService_GetShowtime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServiceServer).GetShowtime(ctx, req.(*GetShowtimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulingService_ServiceDesc is the grpc.ServiceDesc for SchedulingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduling.SchedulingService",
+	HandlerType: (*SchedulingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetScreenTypeFormats",
+			Handler:    _SchedulingService_SetScreenTypeFormats_Handler,
+		},
+		{
+			MethodName: "GetScreenTypeFormats",
+			Handler:    _SchedulingService_GetScreenTypeFormats_Handler,
+		},
+		{
+			MethodName: "AddShowtime",
+			Handler:    _SchedulingService_AddShowtime_Handler,
+		},
+		{
+			MethodName: "SetShowtimeVersion",
+			Handler:    _SchedulingService_SetShowtimeVersion_Handler,
+		},
+		{
+			MethodName: "GetShowtime",
+			Handler:    _SchedulingService_GetShowtime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/pb/scheduling/scheduling.proto",
+}
@@ -0,0 +1,142 @@
+// Package resilience holds the retry and circuit breaker helpers used around
+// calls to other services.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrOpen = errors.New("circuit breaker is open")
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// Breaker opens after threshold consecutive failures and then rejects calls
+// for cooldown. After that a single probe call is let through: success
+// closes the breaker, failure opens it for another cooldown.
+type Breaker struct {
+	threshold int
+	cooldown  time.Duration
+	onChange  func(State)
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker returns a closed breaker. onChange, if not nil, is called with
+// the new state on every transition, with the breaker locked.
+func NewBreaker(threshold int, cooldown time.Duration, onChange func(State)) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &Breaker{threshold: threshold, cooldown: cooldown, onChange: onChange}
+}
+
+// Allow reports whether a call may go ahead. Every allowed call must be
+// followed by Record or Release.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return ErrOpen
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return ErrOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record reports the outcome of an allowed call.
+func (b *Breaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		b.setState(StateClosed)
+		return
+	}
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = time.Now()
+		b.setState(StateOpen)
+	}
+}
+
+// Release ends an allowed call without an outcome, e.g. because the caller
+// gave up on it. A half-open breaker lets the next call probe.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// Call runs fn if the breaker allows it and records whether failed(err). It
+// returns ErrOpen without calling fn while the breaker is open. A call that
+// ends after ctx is done, or panics, is released without an outcome: it says
+// nothing about the service, and the probe slot must not stay taken.
+func (b *Breaker) Call(ctx context.Context, failed func(error) bool, fn func(ctx context.Context) error) error {
+	if err := b.Allow(); err != nil {
+		return err
+	}
+	recorded := false
+	defer func() {
+		if !recorded {
+			b.Release()
+		}
+	}()
+	err := fn(ctx)
+	if ctx.Err() == nil {
+		b.Record(failed(err))
+		recorded = true
+	}
+	return err
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(state)
+	}
+}
@@ -0,0 +1,172 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBreakerOpensAfterThreshold(t *testing.T) {
+	var changes []State
+	b := NewBreaker(3, time.Hour, func(s State) { changes = append(changes, s) })
+
+	for i := 0; i < 2; i++ {
+		mustAllow(t, b)
+		b.Record(true)
+	}
+	mustAllow(t, b)
+	b.Record(false)
+	if b.State() != StateClosed {
+		t.Fatalf("state = %s after a success, want closed", b.State())
+	}
+
+	// The success reset the count, so it takes three more failures.
+	for i := 0; i < 3; i++ {
+		mustAllow(t, b)
+		b.Record(true)
+	}
+	if b.State() != StateOpen {
+		t.Fatalf("state = %s, want open", b.State())
+	}
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("Allow while open = %v, want ErrOpen", err)
+	}
+	if !slices.Equal(changes, []State{StateOpen}) {
+		t.Fatalf("changes = %v, want [open]", changes)
+	}
+}
+
+func TestBreakerHalfOpenProbe(t *testing.T) {
+	tests := []struct {
+		name        string
+		probeFailed bool
+		want        State
+	}{
+		{name: "probe succeeds", probeFailed: false, want: StateClosed},
+		{name: "probe fails", probeFailed: true, want: StateOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes []State
+			b := NewBreaker(1, 20*time.Millisecond, func(s State) { changes = append(changes, s) })
+			mustAllow(t, b)
+			b.Record(true)
+
+			time.Sleep(30 * time.Millisecond)
+			mustAllow(t, b)
+			if b.State() != StateHalfOpen {
+				t.Fatalf("state = %s after the cooldown, want half-open", b.State())
+			}
+			// Only one probe at a time.
+			if err := b.Allow(); !errors.Is(err, ErrOpen) {
+				t.Fatalf("second Allow while probing = %v, want ErrOpen", err)
+			}
+			b.Record(tt.probeFailed)
+			if b.State() != tt.want {
+				t.Fatalf("state = %s, want %s", b.State(), tt.want)
+			}
+			if want := []State{StateOpen, StateHalfOpen, tt.want}; !slices.Equal(changes, want) {
+				t.Fatalf("changes = %v, want %v", changes, want)
+			}
+		})
+	}
+}
+
+func TestNewBreakerMinimumThreshold(t *testing.T) {
+	b := NewBreaker(0, time.Hour, nil)
+	mustAllow(t, b)
+	b.Record(true)
+	if b.State() != StateOpen {
+		t.Fatalf("state = %s, want open after one failure", b.State())
+	}
+}
+
+func TestBreakerCallIgnoresCancelledCalls(t *testing.T) {
+	b := NewBreaker(2, time.Hour, nil)
+	failing := func(context.Context) error { return errors.New("unavailable") }
+	failed := func(err error) bool { return err != nil }
+
+	b.Call(context.Background(), failed, failing)
+	// A call that ended because the caller gave up is no success either, so
+	// it doesn't reset the failure count.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	b.Call(ctx, failed, func(context.Context) error { return nil })
+	b.Call(context.Background(), failed, failing)
+	if b.State() != StateOpen {
+		t.Fatalf("state = %s, want open", b.State())
+	}
+}
+
+func TestBreakerCallReleasesProbe(t *testing.T) {
+	tests := []struct {
+		name  string
+		probe func(b *Breaker)
+	}{
+		{"caller cancelled", func(b *Breaker) {
+			ctx, cancel := context.WithCancel(context.Background())
+			b.Call(ctx, func(error) bool { return true }, func(context.Context) error {
+				cancel()
+				return context.Canceled
+			})
+		}},
+		{"probe panicked", func(b *Breaker) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("Call swallowed the panic")
+				}
+			}()
+			b.Call(context.Background(), func(error) bool { return true }, func(context.Context) error {
+				panic("boom")
+			})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBreaker(1, 20*time.Millisecond, nil)
+			mustAllow(t, b)
+			b.Record(true)
+			time.Sleep(30 * time.Millisecond)
+
+			tt.probe(b)
+			// Without an outcome the breaker stays half-open and lets the
+			// next call probe instead of rejecting calls for good.
+			if b.State() != StateHalfOpen {
+				t.Fatalf("state = %s, want half-open", b.State())
+			}
+			called := false
+			err := b.Call(context.Background(), func(err error) bool { return err != nil }, func(context.Context) error {
+				called = true
+				return nil
+			})
+			if err != nil || !called {
+				t.Fatalf("next probe: called %t, err %v", called, err)
+			}
+			if b.State() != StateClosed {
+				t.Fatalf("state = %s after a successful probe, want closed", b.State())
+			}
+		})
+	}
+}
+
+func TestBreakerCallRejectsWhileOpen(t *testing.T) {
+	b := NewBreaker(1, time.Hour, nil)
+	mustAllow(t, b)
+	b.Record(true)
+	err := b.Call(context.Background(), func(error) bool { return true }, func(context.Context) error {
+		t.Fatal("fn called while open")
+		return nil
+	})
+	if !errors.Is(err, ErrOpen) {
+		t.Fatalf("Call while open = %v, want ErrOpen", err)
+	}
+}
+
+func mustAllow(t *testing.T, b *Breaker) {
+	t.Helper()
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+}
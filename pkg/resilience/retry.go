@@ -0,0 +1,39 @@
+package resilience
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Backoff retries a call up to Attempts times in total, sleeping between
+// attempts for a random duration between half and all of a delay that
+// starts at Initial and doubles up to Max.
+type Backoff struct {
+	Attempts int
+	Initial  time.Duration
+	Max      time.Duration
+}
+
+// Retry calls fn until it succeeds, returns an error retryable rejects, the
+// attempts run out or ctx is done. The last error is returned.
+func (b Backoff) Retry(ctx context.Context, retryable func(error) bool, fn func(ctx context.Context) error) error {
+	delay := b.Initial
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = fn(ctx); err == nil || !retryable(err) || attempt >= b.Attempts {
+			return err
+		}
+		sleep := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		timer := time.NewTimer(sleep)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		if delay *= 2; b.Max > 0 && delay > b.Max {
+			delay = b.Max
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	errTransient = errors.New("transient")
+	errFatal     = errors.New("fatal")
+)
+
+func isTransient(err error) bool { return errors.Is(err, errTransient) }
+
+func TestBackoffRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		results   []error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "first try", results: []error{nil}, wantCalls: 1},
+		{name: "succeeds on retry", results: []error{errTransient, errTransient, nil}, wantCalls: 3},
+		{name: "attempts run out", results: []error{errTransient, errTransient, errTransient, nil}, wantErr: errTransient, wantCalls: 3},
+		{name: "not retryable", results: []error{errFatal, nil}, wantErr: errFatal, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Backoff{Attempts: 3, Initial: time.Millisecond, Max: 2 * time.Millisecond}
+			calls := 0
+			err := b.Retry(context.Background(), isTransient, func(context.Context) error {
+				calls++
+				return tt.results[calls-1]
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestBackoffDelays(t *testing.T) {
+	b := Backoff{Attempts: 4, Initial: 20 * time.Millisecond, Max: 40 * time.Millisecond}
+	var calls []time.Time
+	b.Retry(context.Background(), isTransient, func(context.Context) error {
+		calls = append(calls, time.Now())
+		return errTransient
+	})
+	if len(calls) != 4 {
+		t.Fatalf("calls = %d, want 4", len(calls))
+	}
+	// Each sleep is between half and all of 20ms, 40ms and then the 40ms cap.
+	for i, delay := range []time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+		if slept := calls[i+1].Sub(calls[i]); slept < delay/2 {
+			t.Errorf("sleep %d = %s, want at least %s", i+1, slept, delay/2)
+		}
+	}
+}
+
+func TestBackoffStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := Backoff{Attempts: 5, Initial: time.Hour}
+	calls := 0
+	err := b.Retry(ctx, isTransient, func(context.Context) error {
+		calls++
+		cancel()
+		return errTransient
+	})
+	if !errors.Is(err, errTransient) || calls != 1 {
+		t.Fatalf("err = %v after %d calls, want the last error after 1 call", err, calls)
+	}
+}
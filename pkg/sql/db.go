@@ -2,13 +2,9 @@ package sql
 
 import (
 	"fmt"
-	"log"
 	"sync"
 
 	"github.com/aparnasukesh/movies-booking-svc/config"
-	"github.com/aparnasukesh/movies-booking-svc/internal/app/booking"
-	"github.com/aparnasukesh/movies-booking-svc/internal/app/movies"
-	"github.com/aparnasukesh/movies-booking-svc/internal/app/theatres"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -20,6 +16,8 @@ var (
 	isExist    map[string]bool
 )
 
+// NewSql opens the shared connection. The schema is managed separately by
+// Migrator.
 func NewSql(config config.Config) (*gorm.DB, error) {
 	if dbInstance == nil && !isExist[config.DBName] {
 		mutex.Lock()
@@ -28,26 +26,11 @@ func NewSql(config config.Config) (*gorm.DB, error) {
 			dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s  sslmode=disable", config.DBHost, config.DBUser, config.DBPassword, config.DBName, config.DBPort)
 			db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
 			if err != nil {
-				log.Fatal(err.Error())
-				return nil, err
+				return nil, fmt.Errorf("failed to connect to postgres: %w", err)
 			}
 			dbInstance = db
 		}
 	}
 
-	dbInstance.AutoMigrate(&movies.Movie{})
-	dbInstance.AutoMigrate(&theatres.Theater{})
-	dbInstance.AutoMigrate(&theatres.TheaterType{})
-	dbInstance.AutoMigrate(&theatres.ScreenType{})
-	dbInstance.AutoMigrate(&theatres.SeatCategory{})
-	dbInstance.AutoMigrate(&theatres.TheaterScreen{})
-	dbInstance.AutoMigrate(&theatres.Showtime{})
-	dbInstance.AutoMigrate(&theatres.MovieSchedule{})
-	dbInstance.AutoMigrate(&theatres.Seat{})
-	dbInstance.AutoMigrate(&booking.Booking{})
-	dbInstance.AutoMigrate(&booking.BookingSeat{})
-
-	log.Println("Successfully auto-migrated all tables.")
-
 	return dbInstance, nil
 }
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS staff_assignments;
+DROP TABLE IF EXISTS admissions;
+DROP TABLE IF EXISTS tickets;
+DROP TABLE IF EXISTS booking_seats;
+DROP TABLE IF EXISTS bookings;
+DROP TABLE IF EXISTS seats;
+DROP TABLE IF EXISTS movie_schedules;
+DROP TABLE IF EXISTS showtimes;
+DROP TABLE IF EXISTS theater_screens;
+DROP TABLE IF EXISTS theaters;
+DROP TABLE IF EXISTS seat_categories;
+DROP TABLE IF EXISTS screen_types;
+DROP TABLE IF EXISTS theater_types;
+DROP TABLE IF EXISTS movies;
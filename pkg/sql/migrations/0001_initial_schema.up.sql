@@ -0,0 +1,220 @@
+-- Initial schema. Tables and indexes use IF NOT EXISTS so that databases
+-- previously managed by GORM AutoMigrate are adopted in place; foreign keys
+-- are added separately for the same reason.
+
+CREATE TABLE IF NOT EXISTS movies (
+    id           bigserial PRIMARY KEY,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz,
+    title        varchar(100) NOT NULL,
+    description  text,
+    duration     bigint NOT NULL,
+    genre        varchar(50),
+    release_date timestamptz NOT NULL,
+    rating       decimal(3,1),
+    language     varchar(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS theater_types (
+    id                bigserial PRIMARY KEY,
+    created_at        timestamptz,
+    updated_at        timestamptz,
+    deleted_at        timestamptz,
+    theater_type_name text
+);
+
+CREATE TABLE IF NOT EXISTS screen_types (
+    id               bigserial PRIMARY KEY,
+    created_at       timestamptz,
+    updated_at       timestamptz,
+    deleted_at       timestamptz,
+    screen_type_name text
+);
+
+CREATE TABLE IF NOT EXISTS seat_categories (
+    id                 bigserial PRIMARY KEY,
+    created_at         timestamptz,
+    updated_at         timestamptz,
+    deleted_at         timestamptz,
+    seat_category_name text
+);
+
+CREATE TABLE IF NOT EXISTS theaters (
+    id                bigserial PRIMARY KEY,
+    created_at        timestamptz,
+    updated_at        timestamptz,
+    deleted_at        timestamptz,
+    name              text,
+    place             text,
+    city              text,
+    district          text,
+    state             text,
+    owner_id          bigint,
+    number_of_screens bigint,
+    theater_type_id   bigint
+);
+
+CREATE TABLE IF NOT EXISTS theater_screens (
+    id             bigserial PRIMARY KEY,
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz,
+    theater_id     bigint,
+    screen_number  bigint,
+    seat_capacity  bigint,
+    screen_type_id bigint
+);
+
+CREATE TABLE IF NOT EXISTS showtimes (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    movie_id   bigint,
+    screen_id  bigint,
+    show_date  timestamptz,
+    show_time  timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS movie_schedules (
+    id          bigserial PRIMARY KEY,
+    created_at  timestamptz,
+    updated_at  timestamptz,
+    deleted_at  timestamptz,
+    movie_id    bigint,
+    theater_id  bigint,
+    showtime_id bigint
+);
+
+CREATE TABLE IF NOT EXISTS seats (
+    id                  bigserial PRIMARY KEY,
+    created_at          timestamptz,
+    updated_at          timestamptz,
+    deleted_at          timestamptz,
+    screen_id           bigint,
+    seat_number         text,
+    "row"               text,
+    "column"            bigint,
+    seat_category_id    bigint,
+    seat_category_price numeric
+);
+
+CREATE TABLE IF NOT EXISTS bookings (
+    booking_id     bigserial PRIMARY KEY,
+    deleted_at     timestamptz,
+    user_id        bigint NOT NULL,
+    showtime_id    bigint NOT NULL,
+    screen_id      bigint,
+    booking_date   timestamp NOT NULL,
+    total_amount   decimal(10,2) NOT NULL,
+    payment_status varchar(50) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS booking_seats (
+    booking_id bigint NOT NULL,
+    deleted_at timestamptz,
+    seat_id    bigint NOT NULL,
+    PRIMARY KEY (booking_id, seat_id)
+);
+
+CREATE TABLE IF NOT EXISTS tickets (
+    id           bigserial PRIMARY KEY,
+    booking_id   bigint NOT NULL,
+    showtime_id  bigint NOT NULL,
+    seat_numbers text NOT NULL,
+    payload      text NOT NULL,
+    valid_from   timestamp NOT NULL,
+    valid_until  timestamp NOT NULL,
+    issued_at    timestamp NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS admissions (
+    id          bigserial PRIMARY KEY,
+    booking_id  bigint NOT NULL,
+    seat_id     bigint NOT NULL,
+    seat_number varchar(10) NOT NULL,
+    showtime_id bigint NOT NULL,
+    theater_id  bigint NOT NULL,
+    device_id   varchar(100) NOT NULL,
+    admitted_at timestamp NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS staff_assignments (
+    id          bigserial PRIMARY KEY,
+    theater_id  bigint,
+    admin_id    bigint,
+    role        text,
+    assigned_by bigint,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
+
+-- Soft-delete indexes, matching the names GORM used.
+CREATE INDEX IF NOT EXISTS idx_movies_deleted_at ON movies (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_theater_types_deleted_at ON theater_types (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_screen_types_deleted_at ON screen_types (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_seat_categories_deleted_at ON seat_categories (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_theaters_deleted_at ON theaters (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_theater_screens_deleted_at ON theater_screens (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_showtimes_deleted_at ON showtimes (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_movie_schedules_deleted_at ON movie_schedules (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_seats_deleted_at ON seats (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_bookings_deleted_at ON bookings (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_booking_seats_deleted_at ON booking_seats (deleted_at);
+
+-- Unique constraints previously declared on the models.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tickets_booking_id ON tickets (booking_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_admission_booking_seat ON admissions (booking_id, seat_id);
+CREATE INDEX IF NOT EXISTS idx_admissions_showtime_id ON admissions (showtime_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_staff_theater_admin ON staff_assignments (theater_id, admin_id);
+CREATE INDEX IF NOT EXISTS idx_staff_assignments_admin_id ON staff_assignments (admin_id);
+
+-- Lookup indexes for the repository queries.
+CREATE INDEX IF NOT EXISTS idx_theaters_owner_id ON theaters (owner_id);
+CREATE INDEX IF NOT EXISTS idx_theaters_theater_type_id ON theaters (theater_type_id);
+CREATE INDEX IF NOT EXISTS idx_theater_screens_theater_id ON theater_screens (theater_id);
+CREATE INDEX IF NOT EXISTS idx_showtimes_movie_id ON showtimes (movie_id);
+CREATE INDEX IF NOT EXISTS idx_showtimes_screen_id_show_date ON showtimes (screen_id, show_date);
+CREATE INDEX IF NOT EXISTS idx_movie_schedules_movie_id ON movie_schedules (movie_id);
+CREATE INDEX IF NOT EXISTS idx_movie_schedules_theater_id ON movie_schedules (theater_id);
+CREATE INDEX IF NOT EXISTS idx_movie_schedules_showtime_id ON movie_schedules (showtime_id);
+CREATE INDEX IF NOT EXISTS idx_seats_screen_id ON seats (screen_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_user_id ON bookings (user_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_showtime_id ON bookings (showtime_id);
+CREATE INDEX IF NOT EXISTS idx_booking_seats_seat_id ON booking_seats (seat_id);
+
+-- Foreign keys. Each is skipped if a constraint with the same name exists.
+DO $$
+DECLARE
+    fk record;
+BEGIN
+    FOR fk IN SELECT * FROM (VALUES
+        ('theaters',          'fk_theaters_theater_type',         'theater_type_id',  'theater_types',   'id',         'RESTRICT'),
+        ('theater_screens',   'fk_theater_screens_theater',       'theater_id',       'theaters',        'id',         'RESTRICT'),
+        ('theater_screens',   'fk_theater_screens_screen_type',   'screen_type_id',   'screen_types',    'id',         'RESTRICT'),
+        ('showtimes',         'fk_showtimes_movie',               'movie_id',         'movies',          'id',         'RESTRICT'),
+        ('showtimes',         'fk_showtimes_screen',              'screen_id',        'theater_screens', 'id',         'RESTRICT'),
+        ('movie_schedules',   'fk_movie_schedules_movie',         'movie_id',         'movies',          'id',         'RESTRICT'),
+        ('movie_schedules',   'fk_movie_schedules_theater',       'theater_id',       'theaters',        'id',         'RESTRICT'),
+        ('movie_schedules',   'fk_movie_schedules_showtime',      'showtime_id',      'showtimes',       'id',         'RESTRICT'),
+        ('seats',             'fk_seats_screen',                  'screen_id',        'theater_screens', 'id',         'RESTRICT'),
+        ('seats',             'fk_seats_seat_category',           'seat_category_id', 'seat_categories', 'id',         'RESTRICT'),
+        ('bookings',          'fk_bookings_showtime',             'showtime_id',      'showtimes',       'id',         'RESTRICT'),
+        ('bookings',          'fk_bookings_screen',               'screen_id',        'theater_screens', 'id',         'RESTRICT'),
+        ('booking_seats',     'fk_booking_seats_booking',         'booking_id',       'bookings',        'booking_id', 'CASCADE'),
+        ('booking_seats',     'fk_booking_seats_seat',            'seat_id',          'seats',           'id',         'RESTRICT'),
+        ('tickets',           'fk_tickets_booking',               'booking_id',       'bookings',        'booking_id', 'CASCADE'),
+        ('admissions',        'fk_admissions_booking',            'booking_id',       'bookings',        'booking_id', 'CASCADE'),
+        ('admissions',        'fk_admissions_seat',               'seat_id',          'seats',           'id',         'RESTRICT'),
+        ('admissions',        'fk_admissions_showtime',           'showtime_id',      'showtimes',       'id',         'RESTRICT'),
+        ('admissions',        'fk_admissions_theater',            'theater_id',       'theaters',        'id',         'RESTRICT'),
+        ('staff_assignments', 'fk_staff_assignments_theater',     'theater_id',       'theaters',        'id',         'CASCADE')
+    ) AS t(tbl, name, col, ref_tbl, ref_col, on_delete)
+    LOOP
+        IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = fk.name) THEN
+            EXECUTE format('ALTER TABLE %I ADD CONSTRAINT %I FOREIGN KEY (%I) REFERENCES %I (%I) ON DELETE %s',
+                fk.tbl, fk.name, fk.col, fk.ref_tbl, fk.ref_col, fk.on_delete);
+        END IF;
+    END LOOP;
+END $$;
@@ -0,0 +1,15 @@
+-- Full-text and fuzzy movie search. pg_trgm ships with Postgres contrib;
+-- creating it needs a role allowed to create extensions in the database.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(genre, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_movies_search_vector ON movies USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_movies_title_trgm ON movies USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_movies_genre_trgm ON movies USING gin (genre gin_trgm_ops);
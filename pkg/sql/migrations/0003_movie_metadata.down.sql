@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS movie_tracks;
+DROP TABLE IF EXISTS movie_credits;
+DROP TABLE IF EXISTS people;
+DROP TABLE IF EXISTS movie_genres;
+DROP TABLE IF EXISTS genres;
+ALTER TABLE movies DROP COLUMN IF EXISTS trailer_url;
+ALTER TABLE movies DROP COLUMN IF EXISTS poster_url;
+ALTER TABLE movies DROP COLUMN IF EXISTS certificate;
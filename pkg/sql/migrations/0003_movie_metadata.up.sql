@@ -0,0 +1,77 @@
+-- Genres, cast and crew, certificates, media links and language tracks.
+-- movies.genre is kept as the primary genre so that existing RPCs and the
+-- search vector keep working.
+
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS certificate varchar(4);
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS poster_url text;
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS trailer_url text;
+
+CREATE TABLE IF NOT EXISTS genres (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    name       varchar(50) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_genres_deleted_at ON genres (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_genres_name ON genres (lower(name)) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS movie_genres (
+    movie_id bigint NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    genre_id bigint NOT NULL REFERENCES genres (id) ON DELETE CASCADE,
+    PRIMARY KEY (movie_id, genre_id)
+);
+CREATE INDEX IF NOT EXISTS idx_movie_genres_genre_id ON movie_genres (genre_id);
+
+CREATE TABLE IF NOT EXISTS people (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    name       varchar(100) NOT NULL,
+    biography  text,
+    birth_date timestamptz,
+    image_url  text
+);
+CREATE INDEX IF NOT EXISTS idx_people_deleted_at ON people (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_people_name_trgm ON people USING gin (name gin_trgm_ops);
+
+CREATE TABLE IF NOT EXISTS movie_credits (
+    id            bigserial PRIMARY KEY,
+    movie_id      bigint NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    person_id     bigint NOT NULL REFERENCES people (id) ON DELETE CASCADE,
+    role          varchar(10) NOT NULL,
+    job           varchar(50),
+    "character"   varchar(100),
+    billing_order bigint NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_movie_credits_movie_id ON movie_credits (movie_id);
+CREATE INDEX IF NOT EXISTS idx_movie_credits_person_id ON movie_credits (person_id, role);
+
+CREATE TABLE IF NOT EXISTS movie_tracks (
+    id       bigserial PRIMARY KEY,
+    movie_id bigint NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    kind     varchar(10) NOT NULL,
+    language varchar(100) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_movie_tracks_movie_kind_language ON movie_tracks (movie_id, kind, lower(language));
+
+-- Backfill genres from the single genre column and give every movie an
+-- audio track in its original language.
+INSERT INTO genres (created_at, updated_at, name)
+SELECT now(), now(), min(trim(genre))
+FROM movies
+WHERE deleted_at IS NULL AND trim(coalesce(genre, '')) <> ''
+  AND NOT EXISTS (SELECT 1 FROM genres g WHERE lower(g.name) = lower(trim(movies.genre)) AND g.deleted_at IS NULL)
+GROUP BY lower(trim(genre));
+
+INSERT INTO movie_genres (movie_id, genre_id)
+SELECT m.id, g.id
+FROM movies m
+JOIN genres g ON lower(g.name) = lower(trim(m.genre)) AND g.deleted_at IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO movie_tracks (movie_id, kind, language)
+SELECT m.id, 'audio', m.language
+FROM movies m
+WHERE NOT EXISTS (SELECT 1 FROM movie_tracks t WHERE t.movie_id = m.id AND t.kind = 'audio');
@@ -0,0 +1,5 @@
+ALTER TABLE showtimes DROP CONSTRAINT IF EXISTS fk_showtimes_movie_version;
+DROP INDEX IF EXISTS idx_showtimes_movie_version_id;
+ALTER TABLE showtimes DROP COLUMN IF EXISTS movie_version_id;
+DROP TABLE IF EXISTS screen_type_formats;
+DROP TABLE IF EXISTS movie_versions;
@@ -0,0 +1,58 @@
+-- Movie versions (language, audio, format) referenced by showtimes, and the
+-- formats each screen type can project.
+
+CREATE TABLE IF NOT EXISTS movie_versions (
+    id                bigserial PRIMARY KEY,
+    created_at        timestamptz,
+    updated_at        timestamptz,
+    deleted_at        timestamptz,
+    movie_id          bigint NOT NULL REFERENCES movies (id) ON DELETE CASCADE,
+    language          varchar(100) NOT NULL,
+    audio_type        varchar(10) NOT NULL,
+    subtitle_language varchar(100),
+    format            varchar(10) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_movie_versions_deleted_at ON movie_versions (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_movie_versions_unique ON movie_versions
+    (movie_id, lower(language), audio_type, lower(coalesce(subtitle_language, '')), format)
+    WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS screen_type_formats (
+    screen_type_id bigint NOT NULL REFERENCES screen_types (id) ON DELETE CASCADE,
+    format         varchar(10) NOT NULL,
+    PRIMARY KEY (screen_type_id, format)
+);
+
+ALTER TABLE showtimes ADD COLUMN IF NOT EXISTS movie_version_id bigint;
+
+-- Every existing movie becomes its original language in 2D, and existing
+-- showtimes point at that version.
+INSERT INTO movie_versions (created_at, updated_at, movie_id, language, audio_type, format)
+SELECT now(), now(), m.id, m.language, 'original', '2D'
+FROM movies m
+WHERE NOT EXISTS (SELECT 1 FROM movie_versions v WHERE v.movie_id = m.id);
+
+UPDATE showtimes s
+SET movie_version_id = v.id
+FROM movie_versions v
+WHERE s.movie_version_id IS NULL
+  AND v.movie_id = s.movie_id AND v.audio_type = 'original' AND v.format = '2D';
+
+ALTER TABLE showtimes ALTER COLUMN movie_version_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_showtimes_movie_version_id ON showtimes (movie_version_id);
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_showtimes_movie_version') THEN
+        ALTER TABLE showtimes ADD CONSTRAINT fk_showtimes_movie_version
+            FOREIGN KEY (movie_version_id) REFERENCES movie_versions (id) ON DELETE RESTRICT;
+    END IF;
+END $$;
+
+-- Guess the formats of existing screen types from their names.
+INSERT INTO screen_type_formats (screen_type_id, format)
+SELECT id, '2D' FROM screen_types
+UNION SELECT id, '3D' FROM screen_types WHERE screen_type_name ILIKE '%3D%'
+UNION SELECT id, 'IMAX' FROM screen_types WHERE screen_type_name ILIKE '%IMAX%'
+UNION SELECT id, 'IMAX 3D' FROM screen_types WHERE screen_type_name ILIKE '%IMAX%' AND screen_type_name ILIKE '%3D%'
+UNION SELECT id, '4DX' FROM screen_types WHERE screen_type_name ILIKE '%4DX%'
+ON CONFLICT DO NOTHING;
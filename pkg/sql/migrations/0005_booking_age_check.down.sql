@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookings_age_check;
+ALTER TABLE bookings DROP COLUMN IF EXISTS age_at_show;
+ALTER TABLE bookings DROP COLUMN IF EXISTS age_check_certificate;
+ALTER TABLE bookings DROP COLUMN IF EXISTS age_check;
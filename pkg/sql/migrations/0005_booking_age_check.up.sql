@@ -0,0 +1,8 @@
+-- Result of the age rating check made when the booking was created. Bookings
+-- made before the check existed are left NULL.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS age_check varchar(20);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS age_check_certificate varchar(4);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS age_at_show integer;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_age_check ON bookings (age_check)
+    WHERE age_check IN ('flagged', 'unverified');
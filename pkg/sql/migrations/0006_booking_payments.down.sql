@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_bookings_processing;
+UPDATE bookings SET payment_status = 'Pending' WHERE payment_status = 'Processing';
+ALTER TABLE bookings DROP COLUMN IF EXISTS payment_checked_at;
+ALTER TABLE bookings DROP COLUMN IF EXISTS payment_started_at;
+ALTER TABLE bookings DROP COLUMN IF EXISTS order_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS transaction_id;
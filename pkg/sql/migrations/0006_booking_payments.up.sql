@@ -0,0 +1,9 @@
+-- Payments started by this service. A booking is Processing from the moment
+-- its payment is started until the payment service reports the outcome.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS transaction_id integer;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS order_id varchar(100);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS payment_started_at timestamp;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS payment_checked_at timestamp;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_processing ON bookings (payment_started_at)
+    WHERE payment_status = 'Processing' AND deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payment_mismatches;
+DROP TABLE IF EXISTS payment_reports;
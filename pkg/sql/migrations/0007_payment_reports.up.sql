@@ -0,0 +1,24 @@
+-- Nightly comparison of bookings with the payment service's records.
+CREATE TABLE IF NOT EXISTS payment_reports (
+    id            bigserial PRIMARY KEY,
+    window_start  timestamp NOT NULL,
+    window_end    timestamp NOT NULL,
+    generated_at  timestamp NOT NULL,
+    checked       integer NOT NULL DEFAULT 0,
+    mismatched    integer NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS payment_mismatches (
+    id              bigserial PRIMARY KEY,
+    report_id       bigint NOT NULL REFERENCES payment_reports (id) ON DELETE CASCADE,
+    booking_id      bigint NOT NULL,
+    transaction_id  integer,
+    kind            varchar(30) NOT NULL,
+    booking_status  varchar(50) NOT NULL,
+    payment_status  varchar(50),
+    booking_amount  decimal(10,2) NOT NULL,
+    payment_amount  decimal(10,2)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_mismatches_report_id ON payment_mismatches (report_id);
+CREATE INDEX IF NOT EXISTS idx_payment_mismatches_booking_id ON payment_mismatches (booking_id);
@@ -0,0 +1,2 @@
+-- Fuzzy matching on descriptions for movie search.
+CREATE INDEX IF NOT EXISTS idx_movies_description_trgm ON movies USING gin (description gin_trgm_ops);
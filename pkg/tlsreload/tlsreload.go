@@ -0,0 +1,172 @@
+// Package tlsreload serves TLS with a certificate and client CA that are
+// reloaded when their files change, e.g. when cert-manager rotates a
+// mounted secret.
+package tlsreload
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	clientAuth   tls.ClientAuthType
+	interval     time.Duration
+	logger       *slog.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	versions []fileVersion
+}
+
+// A file counts as changed when its size or modification time does.
+// Kubernetes swaps mounted secrets through a symlink, which this catches
+// where a watch on the old file would not.
+type fileVersion struct {
+	size    int64
+	modTime time.Time
+}
+
+// New loads the certificate and, when clientCAFile is set, the CAs that
+// client certificates are verified against. The files are checked for
+// changes every interval once Run is started.
+func New(certFile, keyFile, clientCAFile string, clientAuth tls.ClientAuthType, interval time.Duration, logger *slog.Logger) (*Reloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("tls needs both a certificate and a key file")
+	}
+	if clientCAFile == "" && clientAuth >= tls.VerifyClientCertIfGiven {
+		return nil, fmt.Errorf("verifying client certificates needs a client ca file")
+	}
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		clientAuth:   clientAuth,
+		interval:     interval,
+		logger:       logger,
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Config returns a server config that picks up the current certificate and
+// client CAs on every handshake.
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   r.clientAuth,
+				ClientCAs:    r.clientCA,
+				// grpc-go requires h2 to be negotiated.
+				NextProtos: []string{"h2"},
+			}, nil
+		},
+	}
+}
+
+// Run reloads the files whenever they change until ctx is done. A change
+// that fails to load is logged and the previous certificate kept.
+func (r *Reloader) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.changed()
+			if err != nil {
+				r.logger.Warn("failed to check tls files", "error", err)
+				continue
+			}
+			if !changed {
+				continue
+			}
+			if err := r.load(); err != nil {
+				r.logger.Error("failed to reload tls files, keeping the previous certificate", "error", err)
+				continue
+			}
+			r.logger.Info("reloaded tls certificate", "cert_file", r.certFile)
+		}
+	}
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+	return files
+}
+
+func (r *Reloader) stat() ([]fileVersion, error) {
+	var versions []fileVersion
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, fileVersion{size: info.Size(), modTime: info.ModTime()})
+	}
+	return versions, nil
+}
+
+func (r *Reloader) changed() (bool, error) {
+	versions, err := r.stat()
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := range versions {
+		if versions[i] != r.versions[i] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *Reloader) load() error {
+	// Stat first so a write racing with the load is picked up next time.
+	versions, err := r.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	var clientCA *x509.CertPool
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client ca file: %w", err)
+		}
+		clientCA = x509.NewCertPool()
+		if !clientCA.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in client ca file %s", r.clientCAFile)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCA = clientCA
+	r.versions = versions
+	return nil
+}
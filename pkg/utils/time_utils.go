@@ -13,3 +13,8 @@ func ParseDateString(dateString string) (*time.Time, error) {
 	}
 	return &parsedDate, nil
 }
+
+// CombineDateAndTime returns the calendar date of date at the clock time of clock.
+func CombineDateAndTime(date, clock time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, date.Location())
+}